@@ -2,20 +2,80 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 
 	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/allowlistidentifier"
+	"github.com/clerk/clerk-sdk-go/v2/blocklistidentifier"
+	"github.com/clerk/clerk-sdk-go/v2/emailaddress"
+	"github.com/clerk/clerk-sdk-go/v2/jwttemplate"
 	"github.com/clerk/clerk-sdk-go/v2/organization"
+	"github.com/clerk/clerk-sdk-go/v2/organizationdomain"
+	"github.com/clerk/clerk-sdk-go/v2/organizationinvitation"
+	"github.com/clerk/clerk-sdk-go/v2/organizationmembership"
+	"github.com/clerk/clerk-sdk-go/v2/phonenumber"
+	"github.com/clerk/clerk-sdk-go/v2/redirecturl"
+	"github.com/clerk/clerk-sdk-go/v2/user"
 )
 
-// ClerkClient wraps the Clerk SDK client configuration
+// ClerkClient wraps the Clerk SDK client configuration.
+// Each provider instance owns its own ClerkClient with its own SDK backend,
+// so aliased providers targeting different Clerk instances never share the
+// SDK's package-level key.
 type ClerkClient struct {
 	APIKey string
+
+	// DeletionProtection is the provider-level default for the
+	// deletion_protection attribute of resources
+	DeletionProtection bool
+
+	organizations           *organization.Client
+	organizationMemberships *organizationmembership.Client
+	organizationInvitations *organizationinvitation.Client
+	organizationDomains     *organizationdomain.Client
+	users                   *user.Client
+	emailAddresses          *emailaddress.Client
+	phoneNumbers            *phonenumber.Client
+	allowlistIdentifiers    *allowlistidentifier.Client
+	blocklistIdentifiers    *blocklistidentifier.Client
+	jwtTemplates            *jwttemplate.Client
+	redirectURLs            *redirecturl.Client
+}
+
+// NewClerkClient creates a ClerkClient whose SDK clients all share a single
+// backend built from the given configuration
+func NewClerkClient(config *clerk.ClientConfig) *ClerkClient {
+	backend := clerk.NewBackend(&config.BackendConfig)
+
+	return &ClerkClient{
+		APIKey:                  *config.Key,
+		organizations:           &organization.Client{Backend: backend},
+		organizationMemberships: &organizationmembership.Client{Backend: backend},
+		organizationInvitations: &organizationinvitation.Client{Backend: backend},
+		organizationDomains:     &organizationdomain.Client{Backend: backend},
+		users:                   &user.Client{Backend: backend},
+		emailAddresses:          &emailaddress.Client{Backend: backend},
+		phoneNumbers:            &phonenumber.Client{Backend: backend},
+		allowlistIdentifiers:    &allowlistidentifier.Client{Backend: backend},
+		blocklistIdentifiers:    &blocklistidentifier.Client{Backend: backend},
+		jwtTemplates:            &jwttemplate.Client{Backend: backend},
+		redirectURLs:            &redirecturl.Client{Backend: backend},
+	}
+}
+
+// isNotFound reports whether an error returned by ClerkClient means the
+// requested object does not exist in Clerk
+func isNotFound(err error) bool {
+	var apiErr *clerk.APIErrorResponse
+	return errors.As(err, &apiErr) && apiErr.HTTPStatusCode == http.StatusNotFound
 }
 
 // CreateOrganization creates a new organization using the Clerk SDK
 func (c *ClerkClient) CreateOrganization(ctx context.Context, params *organization.CreateParams) (*clerk.Organization, error) {
-	org, err := organization.Create(ctx, params)
+	org, err := c.organizations.Create(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create organization: %w", err)
 	}
@@ -24,27 +84,485 @@ func (c *ClerkClient) CreateOrganization(ctx context.Context, params *organizati
 
 // GetOrganization retrieves an organization by ID using the Clerk SDK
 func (c *ClerkClient) GetOrganization(ctx context.Context, id string) (*clerk.Organization, error) {
-	org, err := organization.Get(ctx, id)
+	org, err := c.organizations.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	return org, nil
+}
+
+// GetOrganizationWithParams retrieves an organization by ID or slug using the Clerk SDK
+func (c *ClerkClient) GetOrganizationWithParams(ctx context.Context, idOrSlug string, params *organization.GetParams) (*clerk.Organization, error) {
+	org, err := c.organizations.GetWithParams(ctx, idOrSlug, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get organization: %w", err)
 	}
 	return org, nil
 }
 
+// ListOrganizations retrieves every organization matching the given filters,
+// paging through the results. Any limit or offset in params is overwritten.
+func (c *ClerkClient) ListOrganizations(ctx context.Context, params *organization.ListParams) ([]*clerk.Organization, error) {
+	params.Limit = clerk.Int64(100)
+	params.Offset = clerk.Int64(0)
+	var orgs []*clerk.Organization
+	for {
+		list, err := c.organizations.List(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organizations: %w", err)
+		}
+		orgs = append(orgs, list.Organizations...)
+		*params.Offset += int64(len(list.Organizations))
+		if len(list.Organizations) == 0 || *params.Offset >= list.TotalCount {
+			return orgs, nil
+		}
+	}
+}
+
 // UpdateOrganization updates an existing organization using the Clerk SDK
 func (c *ClerkClient) UpdateOrganization(ctx context.Context, id string, params *organization.UpdateParams) (*clerk.Organization, error) {
-	org, err := organization.Update(ctx, id, params)
+	org, err := c.organizations.Update(ctx, id, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update organization: %w", err)
 	}
 	return org, nil
 }
 
+// UpdateOrganizationMetadata merges the given metadata into the
+// organization's existing metadata. Keys set to null are removed.
+func (c *ClerkClient) UpdateOrganizationMetadata(ctx context.Context, id string, params *organization.UpdateMetadataParams) (*clerk.Organization, error) {
+	org, err := c.organizations.UpdateMetadata(ctx, id, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update organization metadata: %w", err)
+	}
+	return org, nil
+}
+
+// UpdateOrganizationLogo uploads the given file as the organization's
+// logo, replacing any existing one. The file is closed once uploaded.
+func (c *ClerkClient) UpdateOrganizationLogo(ctx context.Context, id string, params *organization.UpdateLogoParams) (*clerk.Organization, error) {
+	org, err := c.organizations.UpdateLogo(ctx, id, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update organization logo: %w", err)
+	}
+	return org, nil
+}
+
+// DeleteOrganizationLogo removes the organization's logo
+func (c *ClerkClient) DeleteOrganizationLogo(ctx context.Context, id string) (*clerk.Organization, error) {
+	org, err := c.organizations.DeleteLogo(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete organization logo: %w", err)
+	}
+	return org, nil
+}
+
 // DeleteOrganization deletes an organization using the Clerk SDK
 func (c *ClerkClient) DeleteOrganization(ctx context.Context, id string) error {
-	_, err := organization.Delete(ctx, id)
+	_, err := c.organizations.Delete(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete organization: %w", err)
 	}
 	return nil
 }
+
+// CreateOrganizationMembership adds a user to an organization using the Clerk SDK
+func (c *ClerkClient) CreateOrganizationMembership(ctx context.Context, params *organizationmembership.CreateParams) (*clerk.OrganizationMembership, error) {
+	membership, err := c.organizationMemberships.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create organization membership: %w", err)
+	}
+	return membership, nil
+}
+
+// GetOrganizationMembership retrieves the membership of a user in an organization.
+// The Clerk API has no endpoint for fetching a single membership, so the
+// organization's memberships are listed with a user_id filter instead.
+// A nil membership is returned when the user is not a member.
+func (c *ClerkClient) GetOrganizationMembership(ctx context.Context, organizationID, userID string) (*clerk.OrganizationMembership, error) {
+	list, err := c.organizationMemberships.List(ctx, &organizationmembership.ListParams{
+		OrganizationID: organizationID,
+		UserIDs:        []string{userID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization membership: %w", err)
+	}
+	for _, membership := range list.OrganizationMemberships {
+		if membership.PublicUserData != nil && membership.PublicUserData.UserID == userID {
+			return membership, nil
+		}
+	}
+	return nil, nil
+}
+
+// UpdateOrganizationMembership updates the role of an organization member using the Clerk SDK
+func (c *ClerkClient) UpdateOrganizationMembership(ctx context.Context, params *organizationmembership.UpdateParams) (*clerk.OrganizationMembership, error) {
+	membership, err := c.organizationMemberships.Update(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update organization membership: %w", err)
+	}
+	return membership, nil
+}
+
+// DeleteOrganizationMembership removes a user from an organization using the Clerk SDK
+func (c *ClerkClient) DeleteOrganizationMembership(ctx context.Context, params *organizationmembership.DeleteParams) error {
+	_, err := c.organizationMemberships.Delete(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete organization membership: %w", err)
+	}
+	return nil
+}
+
+// CreateOrganizationInvitation creates and sends an organization invitation using the Clerk SDK
+func (c *ClerkClient) CreateOrganizationInvitation(ctx context.Context, params *organizationinvitation.CreateParams) (*clerk.OrganizationInvitation, error) {
+	invitation, err := c.organizationInvitations.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create organization invitation: %w", err)
+	}
+	return invitation, nil
+}
+
+// GetOrganizationInvitation retrieves an organization invitation by ID using the Clerk SDK
+func (c *ClerkClient) GetOrganizationInvitation(ctx context.Context, organizationID, id string) (*organizationInvitation, error) {
+	path, err := clerk.JoinPath("/organizations", organizationID, "/invitations", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization invitation: %w", err)
+	}
+
+	// The SDK does not decode every field of the invitation, so the request
+	// is sent through its backend directly
+	invitation := &organizationInvitation{}
+	if err := c.organizationInvitations.Backend.Call(ctx, clerk.NewAPIRequest(http.MethodGet, path), invitation); err != nil {
+		return nil, fmt.Errorf("failed to get organization invitation: %w", err)
+	}
+	return invitation, nil
+}
+
+// organizationInvitation is an organization invitation as returned by the
+// Clerk API, including the fields the SDK does not decode
+type organizationInvitation struct {
+	clerk.OrganizationInvitation
+	RedirectURL   *string `json:"redirect_url"`
+	InviterUserID *string `json:"inviter_user_id"`
+}
+
+// RevokeOrganizationInvitation revokes a pending organization invitation using the Clerk SDK
+func (c *ClerkClient) RevokeOrganizationInvitation(ctx context.Context, params *organizationinvitation.RevokeParams) (*clerk.OrganizationInvitation, error) {
+	invitation, err := c.organizationInvitations.Revoke(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke organization invitation: %w", err)
+	}
+	return invitation, nil
+}
+
+// CreateOrganizationDomain adds a domain to an organization using the Clerk SDK
+func (c *ClerkClient) CreateOrganizationDomain(ctx context.Context, organizationID string, params *organizationdomain.CreateParams) (*clerk.OrganizationDomain, error) {
+	domain, err := c.organizationDomains.Create(ctx, organizationID, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create organization domain: %w", err)
+	}
+	return domain, nil
+}
+
+// GetOrganizationDomain retrieves an organization domain by ID.
+// The Clerk API has no endpoint for fetching a single domain, so the
+// organization's domains are paged through until the ID is found.
+// A nil domain is returned when the organization has no such domain.
+func (c *ClerkClient) GetOrganizationDomain(ctx context.Context, organizationID, id string) (*clerk.OrganizationDomain, error) {
+	params := &organizationdomain.ListParams{}
+	params.Limit = clerk.Int64(100)
+	params.Offset = clerk.Int64(0)
+	for {
+		list, err := c.organizationDomains.List(ctx, organizationID, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get organization domain: %w", err)
+		}
+		for _, domain := range list.OrganizationDomains {
+			if domain.ID == id {
+				return domain, nil
+			}
+		}
+		*params.Offset += int64(len(list.OrganizationDomains))
+		if len(list.OrganizationDomains) == 0 || *params.Offset >= list.TotalCount {
+			return nil, nil
+		}
+	}
+}
+
+// UpdateOrganizationDomain updates an organization domain using the Clerk SDK
+func (c *ClerkClient) UpdateOrganizationDomain(ctx context.Context, params *organizationdomain.UpdateParams) (*clerk.OrganizationDomain, error) {
+	domain, err := c.organizationDomains.Update(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update organization domain: %w", err)
+	}
+	return domain, nil
+}
+
+// DeleteOrganizationDomain removes a domain from an organization using the Clerk SDK
+func (c *ClerkClient) DeleteOrganizationDomain(ctx context.Context, params *organizationdomain.DeleteParams) error {
+	_, err := c.organizationDomains.Delete(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to delete organization domain: %w", err)
+	}
+	return nil
+}
+
+// CreateUser creates a new user using the Clerk SDK
+func (c *ClerkClient) CreateUser(ctx context.Context, params *user.CreateParams) (*clerk.User, error) {
+	usr, err := c.users.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+	return usr, nil
+}
+
+// GetUser retrieves a user by ID using the Clerk SDK
+func (c *ClerkClient) GetUser(ctx context.Context, id string) (*clerk.User, error) {
+	usr, err := c.users.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return usr, nil
+}
+
+// UpdateUser updates an existing user using the Clerk SDK
+func (c *ClerkClient) UpdateUser(ctx context.Context, id string, params *user.UpdateParams) (*clerk.User, error) {
+	usr, err := c.users.Update(ctx, id, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+	return usr, nil
+}
+
+// DeleteUser deletes a user using the Clerk SDK
+func (c *ClerkClient) DeleteUser(ctx context.Context, id string) error {
+	_, err := c.users.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+	return nil
+}
+
+// CreateEmailAddress adds an email address to a user using the Clerk SDK
+func (c *ClerkClient) CreateEmailAddress(ctx context.Context, params *emailaddress.CreateParams) (*clerk.EmailAddress, error) {
+	email, err := c.emailAddresses.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create email address: %w", err)
+	}
+	return email, nil
+}
+
+// DeleteEmailAddress removes an email address from a user using the Clerk SDK
+func (c *ClerkClient) DeleteEmailAddress(ctx context.Context, id string) error {
+	_, err := c.emailAddresses.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete email address: %w", err)
+	}
+	return nil
+}
+
+// CreatePhoneNumber adds a phone number to a user using the Clerk SDK
+func (c *ClerkClient) CreatePhoneNumber(ctx context.Context, params *phonenumber.CreateParams) (*clerk.PhoneNumber, error) {
+	phone, err := c.phoneNumbers.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create phone number: %w", err)
+	}
+	return phone, nil
+}
+
+// DeletePhoneNumber removes a phone number from a user using the Clerk SDK
+func (c *ClerkClient) DeletePhoneNumber(ctx context.Context, id string) error {
+	_, err := c.phoneNumbers.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete phone number: %w", err)
+	}
+	return nil
+}
+
+// listParams pages through list endpoints whose SDK methods do not accept
+// a limit and offset
+type listParams struct {
+	clerk.APIParams
+	clerk.ListParams
+}
+
+// ToQuery returns the limit and offset as query string values
+func (params *listParams) ToQuery() url.Values {
+	return params.ListParams.ToQuery()
+}
+
+// CreateAllowlistIdentifier adds an identifier to the allowlist using the Clerk SDK
+func (c *ClerkClient) CreateAllowlistIdentifier(ctx context.Context, params *allowlistidentifier.CreateParams) (*clerk.AllowlistIdentifier, error) {
+	identifier, err := c.allowlistIdentifiers.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create allowlist identifier: %w", err)
+	}
+	return identifier, nil
+}
+
+// ListAllowlistIdentifiers retrieves every identifier on the allowlist,
+// paging through the results
+func (c *ClerkClient) ListAllowlistIdentifiers(ctx context.Context) ([]*clerk.AllowlistIdentifier, error) {
+	params := &listParams{}
+	params.Limit = clerk.Int64(100)
+	params.Offset = clerk.Int64(0)
+	var identifiers []*clerk.AllowlistIdentifier
+	for {
+		req := clerk.NewAPIRequest(http.MethodGet, "/allowlist_identifiers?paginated=true")
+		req.SetParams(params)
+		list := &clerk.AllowlistIdentifierList{}
+		if err := c.allowlistIdentifiers.Backend.Call(ctx, req, list); err != nil {
+			return nil, fmt.Errorf("failed to list allowlist identifiers: %w", err)
+		}
+		identifiers = append(identifiers, list.AllowlistIdentifiers...)
+		*params.Offset += int64(len(list.AllowlistIdentifiers))
+		if len(list.AllowlistIdentifiers) == 0 || *params.Offset >= list.TotalCount {
+			return identifiers, nil
+		}
+	}
+}
+
+// GetAllowlistIdentifier retrieves an allowlist identifier by ID.
+// The Clerk API has no endpoint for fetching a single identifier, so the
+// allowlist is paged through until the ID is found.
+// A nil identifier is returned when the allowlist has no such identifier.
+func (c *ClerkClient) GetAllowlistIdentifier(ctx context.Context, id string) (*clerk.AllowlistIdentifier, error) {
+	identifiers, err := c.ListAllowlistIdentifiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, identifier := range identifiers {
+		if identifier.ID == id {
+			return identifier, nil
+		}
+	}
+	return nil, nil
+}
+
+// DeleteAllowlistIdentifier removes an identifier from the allowlist using the Clerk SDK
+func (c *ClerkClient) DeleteAllowlistIdentifier(ctx context.Context, id string) error {
+	_, err := c.allowlistIdentifiers.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete allowlist identifier: %w", err)
+	}
+	return nil
+}
+
+// CreateBlocklistIdentifier adds an identifier to the blocklist using the Clerk SDK
+func (c *ClerkClient) CreateBlocklistIdentifier(ctx context.Context, params *blocklistidentifier.CreateParams) (*clerk.BlocklistIdentifier, error) {
+	identifier, err := c.blocklistIdentifiers.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blocklist identifier: %w", err)
+	}
+	return identifier, nil
+}
+
+// ListBlocklistIdentifiers retrieves every identifier on the blocklist,
+// paging through the results
+func (c *ClerkClient) ListBlocklistIdentifiers(ctx context.Context) ([]*clerk.BlocklistIdentifier, error) {
+	params := &listParams{}
+	params.Limit = clerk.Int64(100)
+	params.Offset = clerk.Int64(0)
+	var identifiers []*clerk.BlocklistIdentifier
+	for {
+		req := clerk.NewAPIRequest(http.MethodGet, "/blocklist_identifiers?paginated=true")
+		req.SetParams(params)
+		list := &clerk.BlocklistIdentifierList{}
+		if err := c.blocklistIdentifiers.Backend.Call(ctx, req, list); err != nil {
+			return nil, fmt.Errorf("failed to list blocklist identifiers: %w", err)
+		}
+		identifiers = append(identifiers, list.BlocklistIdentifiers...)
+		*params.Offset += int64(len(list.BlocklistIdentifiers))
+		if len(list.BlocklistIdentifiers) == 0 || *params.Offset >= list.TotalCount {
+			return identifiers, nil
+		}
+	}
+}
+
+// GetBlocklistIdentifier retrieves a blocklist identifier by ID.
+// Like the allowlist, the blocklist can only be searched by listing it.
+// A nil identifier is returned when the blocklist has no such identifier.
+func (c *ClerkClient) GetBlocklistIdentifier(ctx context.Context, id string) (*clerk.BlocklistIdentifier, error) {
+	identifiers, err := c.ListBlocklistIdentifiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, identifier := range identifiers {
+		if identifier.ID == id {
+			return identifier, nil
+		}
+	}
+	return nil, nil
+}
+
+// DeleteBlocklistIdentifier removes an identifier from the blocklist using the Clerk SDK
+func (c *ClerkClient) DeleteBlocklistIdentifier(ctx context.Context, id string) error {
+	_, err := c.blocklistIdentifiers.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete blocklist identifier: %w", err)
+	}
+	return nil
+}
+
+// CreateJWTTemplate creates a new JWT template using the Clerk SDK
+func (c *ClerkClient) CreateJWTTemplate(ctx context.Context, params *jwttemplate.CreateParams) (*clerk.JWTTemplate, error) {
+	template, err := c.jwtTemplates.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JWT template: %w", err)
+	}
+	return template, nil
+}
+
+// GetJWTTemplate retrieves a JWT template by ID using the Clerk SDK
+func (c *ClerkClient) GetJWTTemplate(ctx context.Context, id string) (*clerk.JWTTemplate, error) {
+	template, err := c.jwtTemplates.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get JWT template: %w", err)
+	}
+	return template, nil
+}
+
+// UpdateJWTTemplate updates an existing JWT template using the Clerk SDK
+func (c *ClerkClient) UpdateJWTTemplate(ctx context.Context, id string, params *jwttemplate.UpdateParams) (*clerk.JWTTemplate, error) {
+	template, err := c.jwtTemplates.Update(ctx, id, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update JWT template: %w", err)
+	}
+	return template, nil
+}
+
+// DeleteJWTTemplate deletes a JWT template using the Clerk SDK
+func (c *ClerkClient) DeleteJWTTemplate(ctx context.Context, id string) error {
+	_, err := c.jwtTemplates.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete JWT template: %w", err)
+	}
+	return nil
+}
+
+// CreateRedirectURL registers a new redirect URL using the Clerk SDK
+func (c *ClerkClient) CreateRedirectURL(ctx context.Context, params *redirecturl.CreateParams) (*clerk.RedirectURL, error) {
+	redirectURL, err := c.redirectURLs.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create redirect URL: %w", err)
+	}
+	return redirectURL, nil
+}
+
+// GetRedirectURL retrieves a redirect URL by ID using the Clerk SDK
+func (c *ClerkClient) GetRedirectURL(ctx context.Context, id string) (*clerk.RedirectURL, error) {
+	redirectURL, err := c.redirectURLs.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get redirect URL: %w", err)
+	}
+	return redirectURL, nil
+}
+
+// DeleteRedirectURL deletes a redirect URL using the Clerk SDK
+func (c *ClerkClient) DeleteRedirectURL(ctx context.Context, id string) error {
+	_, err := c.redirectURLs.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete redirect URL: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/organization"
+)
+
+func TestClerkClient_isolatedPerInstance(t *testing.T) {
+	newServer := func(t *testing.T, wantKey string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if got := r.Header.Get("Authorization"); got != "Bearer "+wantKey {
+				t.Errorf("expected key %q, got Authorization header %q", wantKey, got)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"object":"organization","id":"org_` + wantKey + `"}`))
+		}))
+	}
+
+	dev := newServer(t, "sk_test_dev")
+	defer dev.Close()
+	prod := newServer(t, "sk_live_prod")
+	defer prod.Close()
+
+	devClient := NewClerkClient(&clerk.ClientConfig{
+		BackendConfig: clerk.BackendConfig{Key: clerk.String("sk_test_dev"), URL: clerk.String(dev.URL)},
+	})
+	prodClient := NewClerkClient(&clerk.ClientConfig{
+		BackendConfig: clerk.BackendConfig{Key: clerk.String("sk_live_prod"), URL: clerk.String(prod.URL)},
+	})
+
+	// Interleave calls so any shared global state would leak between clients
+	for _, tc := range []struct {
+		client *ClerkClient
+		wantID string
+	}{
+		{devClient, "org_sk_test_dev"},
+		{prodClient, "org_sk_live_prod"},
+		{devClient, "org_sk_test_dev"},
+	} {
+		org, err := tc.client.GetOrganization(context.Background(), "org_123")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if org.ID != tc.wantID {
+			t.Errorf("expected organization %q, got %q", tc.wantID, org.ID)
+		}
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/organizations/org_gone" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[{"code":"resource_not_found","message":"not found"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":[{"code":"authorization_invalid","message":"forbidden"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClerkClient(&clerk.ClientConfig{
+		BackendConfig: clerk.BackendConfig{Key: clerk.String("sk_test"), URL: clerk.String(server.URL)},
+	})
+
+	_, err := client.GetOrganization(context.Background(), "org_gone")
+	if !isNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+
+	_, err = client.GetOrganization(context.Background(), "org_forbidden")
+	if err == nil || isNotFound(err) {
+		t.Errorf("expected an error other than not found, got %v", err)
+	}
+}
+
+func TestClerkClient_ListOrganizations(t *testing.T) {
+	const total = 250
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("query"); got != "acme" {
+			t.Errorf("expected query %q, got %q", "acme", got)
+		}
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		var data string
+		for i := offset; i < offset+limit && i < total; i++ {
+			if data != "" {
+				data += ","
+			}
+			data += fmt.Sprintf(`{"object":"organization","id":"org_%d"}`, i)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"data":[%s],"total_count":%d}`, data, total)
+	}))
+	defer server.Close()
+
+	client := NewClerkClient(&clerk.ClientConfig{
+		BackendConfig: clerk.BackendConfig{Key: clerk.String("sk_test"), URL: clerk.String(server.URL)},
+	})
+
+	orgs, err := client.ListOrganizations(context.Background(), &organization.ListParams{Query: clerk.String("acme")})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(orgs) != total {
+		t.Fatalf("expected %d organizations, got %d", total, len(orgs))
+	}
+	for i, org := range orgs {
+		if want := fmt.Sprintf("org_%d", i); org.ID != want {
+			t.Errorf("expected organization %d to be %q, got %q", i, want, org.ID)
+		}
+	}
+}
+
+func TestClerkClient_GetAllowlistIdentifier(t *testing.T) {
+	const total = 150
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("paginated"); got != "true" {
+			t.Errorf("expected a paginated request, got paginated=%q", got)
+		}
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		var data string
+		for i := offset; i < offset+limit && i < total; i++ {
+			if data != "" {
+				data += ","
+			}
+			data += fmt.Sprintf(`{"object":"allowlist_identifier","id":"alid_%d"}`, i)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"data":[%s],"total_count":%d}`, data, total)
+	}))
+	defer server.Close()
+
+	client := NewClerkClient(&clerk.ClientConfig{
+		BackendConfig: clerk.BackendConfig{Key: clerk.String("sk_test"), URL: clerk.String(server.URL)},
+	})
+
+	// The identifier is on the second page
+	identifier, err := client.GetAllowlistIdentifier(context.Background(), "alid_120")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if identifier == nil || identifier.ID != "alid_120" {
+		t.Errorf("expected identifier %q, got %v", "alid_120", identifier)
+	}
+
+	identifier, err = client.GetAllowlistIdentifier(context.Background(), "alid_missing")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if identifier != nil {
+		t.Errorf("expected no identifier, got %q", identifier.ID)
+	}
+}
+
+func TestClerkClient_GetOrganizationInvitation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want := "/organizations/org_123/invitations/orginv_123"; r.URL.Path != want {
+			t.Errorf("expected path %q, got %q", want, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"object":"organization_invitation","id":"orginv_123","status":"pending",` +
+			`"redirect_url":"https://app.example.com/welcome","inviter_user_id":"user_123"}`))
+	}))
+	defer server.Close()
+
+	client := NewClerkClient(&clerk.ClientConfig{
+		BackendConfig: clerk.BackendConfig{Key: clerk.String("sk_test"), URL: clerk.String(server.URL)},
+	})
+
+	invitation, err := client.GetOrganizationInvitation(context.Background(), "org_123", "orginv_123")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if invitation.ID != "orginv_123" || invitation.Status != "pending" {
+		t.Errorf("expected pending invitation %q, got %q (%s)", "orginv_123", invitation.ID, invitation.Status)
+	}
+	if invitation.RedirectURL == nil || *invitation.RedirectURL != "https://app.example.com/welcome" {
+		t.Errorf("expected the redirect URL to be decoded, got %v", invitation.RedirectURL)
+	}
+	if invitation.InviterUserID == nil || *invitation.InviterUserID != "user_123" {
+		t.Errorf("expected the inviter user ID to be decoded, got %v", invitation.InviterUserID)
+	}
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/organization"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource                   = &organizationDataSource{}
+	_ datasource.DataSourceWithConfigure      = &organizationDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &organizationDataSource{}
+)
+
+// NewOrganizationDataSource is a helper function to simplify the provider implementation
+func NewOrganizationDataSource() datasource.DataSource {
+	return &organizationDataSource{}
+}
+
+// organizationDataSource is the data source implementation
+type organizationDataSource struct {
+	client *ClerkClient
+}
+
+// organizationDataSourceModel describes the data source data model
+type organizationDataSourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Slug                  types.String `tfsdk:"slug"`
+	Name                  types.String `tfsdk:"name"`
+	MaxAllowedMemberships types.Int64  `tfsdk:"max_allowed_memberships"`
+	PublicMetadata        jsonString   `tfsdk:"public_metadata"`
+	PrivateMetadata       jsonString   `tfsdk:"private_metadata"`
+	MembersCount          types.Int64  `tfsdk:"members_count"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name
+func (d *organizationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+// Schema defines the schema for the data source
+func (d *organizationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing Clerk organization by ID or slug.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the organization. Exactly one of id or slug must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"slug": schema.StringAttribute{
+				Description: "The slug of the organization. Exactly one of id or slug must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the organization.",
+				Computed:    true,
+			},
+			"max_allowed_memberships": schema.Int64Attribute{
+				Description: "The maximum number of memberships allowed for the organization.",
+				Computed:    true,
+			},
+			"public_metadata": schema.StringAttribute{
+				Description: "Public metadata for the organization (JSON string).",
+				CustomType:  jsonStringType{},
+				Computed:    true,
+			},
+			"private_metadata": schema.StringAttribute{
+				Description: "Private metadata for the organization (JSON string).",
+				CustomType:  jsonStringType{},
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"members_count": schema.Int64Attribute{
+				Description: "The number of members of the organization.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the organization was created, in RFC 3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *organizationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *ClerkClient, got something else. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig ensures the organization is looked up by exactly one of id or slug
+func (d *organizationDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config organizationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values that are not known yet will be checked once they are
+	if config.ID.IsUnknown() || config.Slug.IsUnknown() {
+		return
+	}
+
+	if config.ID.IsNull() == config.Slug.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid Organization Lookup",
+			"Exactly one of id or slug must be set to look up an organization.",
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *organizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config organizationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The Clerk API accepts either the ID or the slug in the same endpoint
+	idOrSlug := config.ID.ValueString()
+	if config.ID.IsNull() {
+		idOrSlug = config.Slug.ValueString()
+	}
+
+	org, err := d.client.GetOrganizationWithParams(ctx, idOrSlug, &organization.GetParams{
+		IncludeMembersCount: clerk.Bool(true),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading organization",
+			"Could not read organization "+idOrSlug+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	state := organizationDataSourceModel{
+		ID:              types.StringValue(org.ID),
+		Slug:            types.StringValue(org.Slug),
+		Name:            types.StringValue(org.Name),
+		MembersCount:    types.Int64PointerValue(org.MembersCount),
+		CreatedAt:       timestampValue(org.CreatedAt),
+		PublicMetadata:  flattenMetadata(org.PublicMetadata),
+		PrivateMetadata: flattenMetadata(org.PrivateMetadata),
+	}
+
+	if org.MaxAllowedMemberships > 0 {
+		state.MaxAllowedMemberships = types.Int64Value(org.MaxAllowedMemberships)
+	} else {
+		state.MaxAllowedMemberships = types.Int64Null()
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// timestampValue converts a Clerk timestamp in milliseconds since the epoch
+// to an RFC 3339 string
+func timestampValue(ms int64) types.String {
+	if ms == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(time.UnixMilli(ms).UTC().Format(time.RFC3339))
+}
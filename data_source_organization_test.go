@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationDataSource(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("ds-org-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Look up the same organization by ID and by slug
+			{
+				Config: testAccOrganizationDataSourceConfig(slug),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.clerk_organization.by_id", "slug", "clerk_organization.test", "slug"),
+					resource.TestCheckResourceAttrPair("data.clerk_organization.by_id", "name", "clerk_organization.test", "name"),
+					resource.TestCheckResourceAttrPair("data.clerk_organization.by_slug", "id", "clerk_organization.test", "id"),
+					resource.TestCheckResourceAttr("data.clerk_organization.by_slug", "max_allowed_memberships", "25"),
+					resource.TestCheckResourceAttr("data.clerk_organization.by_slug", "members_count", "0"),
+					resource.TestCheckResourceAttrSet("data.clerk_organization.by_slug", "public_metadata"),
+					resource.TestCheckResourceAttrSet("data.clerk_organization.by_slug", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationDataSource_invalidLookup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      `data "clerk_organization" "test" {}`,
+				ExpectError: regexp.MustCompile(`Exactly one of id or slug must be set`),
+			},
+		},
+	})
+}
+
+func testAccOrganizationDataSourceConfig(slug string) string {
+	return fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  name                    = "Data Source Org"
+  slug                    = %[1]q
+  max_allowed_memberships = 25
+
+  public_metadata = jsonencode({
+    tier = "premium"
+  })
+}
+
+data "clerk_organization" "by_id" {
+  id = clerk_organization.test.id
+}
+
+data "clerk_organization" "by_slug" {
+  slug = clerk_organization.test.slug
+}
+`, slug)
+}
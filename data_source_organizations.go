@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/organization"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &organizationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationsDataSource{}
+)
+
+// NewOrganizationsDataSource is a helper function to simplify the provider implementation
+func NewOrganizationsDataSource() datasource.DataSource {
+	return &organizationsDataSource{}
+}
+
+// organizationsDataSource is the data source implementation
+type organizationsDataSource struct {
+	client *ClerkClient
+}
+
+// organizationsDataSourceModel describes the data source data model
+type organizationsDataSourceModel struct {
+	Query               types.String                  `tfsdk:"query"`
+	UserIDs             []types.String                `tfsdk:"user_ids"`
+	OrderBy             types.String                  `tfsdk:"order_by"`
+	IncludeMembersCount types.Bool                    `tfsdk:"include_members_count"`
+	Organizations       []organizationsDataSourceItem `tfsdk:"organizations"`
+}
+
+// organizationsDataSourceItem describes a single organization in the list
+type organizationsDataSourceItem struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Slug                  types.String `tfsdk:"slug"`
+	MaxAllowedMemberships types.Int64  `tfsdk:"max_allowed_memberships"`
+	PublicMetadata        jsonString   `tfsdk:"public_metadata"`
+	PrivateMetadata       jsonString   `tfsdk:"private_metadata"`
+	CreatedBy             types.String `tfsdk:"created_by"`
+	MembersCount          types.Int64  `tfsdk:"members_count"`
+}
+
+// Metadata returns the data source type name
+func (d *organizationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organizations"
+}
+
+// Schema defines the schema for the data source
+func (d *organizationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Clerk organizations matching the given filters.",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Description: "Only return organizations whose ID, name or slug contains this value.",
+				Optional:    true,
+			},
+			"user_ids": schema.ListAttribute{
+				Description: "Only return organizations that at least one of these users is a member of.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"order_by": schema.StringAttribute{
+				Description: "The field to order the organizations by, e.g. name, created_at or members_count. " +
+					"Prefix with + for ascending or - for descending order. Defaults to -created_at.",
+				Optional: true,
+			},
+			"include_members_count": schema.BoolAttribute{
+				Description: "Whether to populate members_count for each organization.",
+				Optional:    true,
+			},
+			"organizations": schema.ListNestedAttribute{
+				Description: "The organizations matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the organization.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the organization.",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "The slug of the organization.",
+							Computed:    true,
+						},
+						"max_allowed_memberships": schema.Int64Attribute{
+							Description: "The maximum number of memberships allowed for the organization.",
+							Computed:    true,
+						},
+						"public_metadata": schema.StringAttribute{
+							Description: "Public metadata for the organization (JSON string).",
+							CustomType:  jsonStringType{},
+							Computed:    true,
+						},
+						"private_metadata": schema.StringAttribute{
+							Description: "Private metadata for the organization (JSON string).",
+							CustomType:  jsonStringType{},
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"created_by": schema.StringAttribute{
+							Description: "The user ID who created the organization.",
+							Computed:    true,
+						},
+						"members_count": schema.Int64Attribute{
+							Description: "The number of members of the organization. Only set when include_members_count is true.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *organizationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *ClerkClient, got something else. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *organizationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state organizationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the list parameters from the filters
+	params := &organization.ListParams{}
+
+	if !state.Query.IsNull() {
+		params.Query = clerk.String(state.Query.ValueString())
+	}
+	if !state.OrderBy.IsNull() {
+		params.OrderBy = clerk.String(state.OrderBy.ValueString())
+	}
+	if !state.IncludeMembersCount.IsNull() {
+		params.IncludeMembersCount = clerk.Bool(state.IncludeMembersCount.ValueBool())
+	}
+	for _, userID := range state.UserIDs {
+		params.UserIDs = append(params.UserIDs, userID.ValueString())
+	}
+
+	orgs, err := d.client.ListOrganizations(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing organizations",
+			"Could not list organizations: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	state.Organizations = make([]organizationsDataSourceItem, 0, len(orgs))
+	for _, org := range orgs {
+		item := organizationsDataSourceItem{
+			ID:              types.StringValue(org.ID),
+			Name:            types.StringValue(org.Name),
+			Slug:            types.StringValue(org.Slug),
+			MembersCount:    types.Int64PointerValue(org.MembersCount),
+			PublicMetadata:  flattenMetadata(org.PublicMetadata),
+			PrivateMetadata: flattenMetadata(org.PrivateMetadata),
+		}
+
+		if org.MaxAllowedMemberships > 0 {
+			item.MaxAllowedMemberships = types.Int64Value(org.MaxAllowedMemberships)
+		} else {
+			item.MaxAllowedMemberships = types.Int64Null()
+		}
+
+		if org.CreatedBy != "" {
+			item.CreatedBy = types.StringValue(org.CreatedBy)
+		} else {
+			item.CreatedBy = types.StringNull()
+		}
+
+		state.Organizations = append(state.Organizations, item)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
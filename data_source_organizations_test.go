@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationsDataSource(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	prefix := fmt.Sprintf("ds-orgs-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Only the organizations created by this test match the query
+			{
+				Config: testAccOrganizationsDataSourceConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.clerk_organizations.test", "organizations.#", "2"),
+					resource.TestCheckResourceAttrPair("data.clerk_organizations.test", "organizations.0.id", "clerk_organization.a", "id"),
+					resource.TestCheckResourceAttrPair("data.clerk_organizations.test", "organizations.1.id", "clerk_organization.b", "id"),
+					resource.TestCheckResourceAttr("data.clerk_organizations.test", "organizations.0.members_count", "0"),
+					resource.TestCheckResourceAttr("data.clerk_organizations.test", "organizations.1.max_allowed_memberships", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationsDataSourceConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "clerk_organization" "a" {
+  name = "%[1]s-a"
+  slug = "%[1]s-a"
+}
+
+resource "clerk_organization" "b" {
+  name                    = "%[1]s-b"
+  slug                    = "%[1]s-b"
+  max_allowed_memberships = 5
+}
+
+data "clerk_organizations" "test" {
+  query                 = %[1]q
+  order_by              = "+name"
+  include_members_count = true
+
+  depends_on = [clerk_organization.a, clerk_organization.b]
+}
+`, prefix)
+}
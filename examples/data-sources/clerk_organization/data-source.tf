@@ -0,0 +1,13 @@
+# Look up an organization managed in another stack by its slug
+data "clerk_organization" "acme" {
+  slug = "acme"
+}
+
+# Or by its ID
+data "clerk_organization" "by_id" {
+  id = "org_2abcdefghijklmnop"
+}
+
+output "acme_members_count" {
+  value = data.clerk_organization.acme.members_count
+}
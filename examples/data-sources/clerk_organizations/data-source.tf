@@ -0,0 +1,18 @@
+# All organizations a user belongs to, largest first
+data "clerk_organizations" "member_of" {
+  user_ids              = ["user_2abcdefghijklmnop"]
+  order_by              = "-members_count"
+  include_members_count = true
+}
+
+# Attach a domain to every organization whose name or slug contains "tenant"
+data "clerk_organizations" "tenants" {
+  query = "tenant"
+}
+
+resource "clerk_organization_domain" "tenant" {
+  for_each = { for org in data.clerk_organizations.tenants.organizations : org.slug => org.id }
+
+  organization_id = each.value
+  name            = "${each.key}.example.com"
+}
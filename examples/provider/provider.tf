@@ -9,4 +9,7 @@ terraform {
 provider "clerk" {
   # Configuration via CLERK_API_KEY environment variable is recommended
   # api_key = "sk_test_your_api_key_here"
+
+  # Optionally target a different Backend API endpoint (or set CLERK_API_URL)
+  # api_url = "https://api.clerk.com/v1"
 }
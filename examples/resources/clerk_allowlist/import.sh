@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import the existing allowlist of the instance
+terraform import clerk_allowlist.example allowlist
@@ -0,0 +1,9 @@
+# Only employees and a few partners can sign up. Identifiers added in the
+# dashboard are removed on the next apply.
+resource "clerk_allowlist" "staging" {
+  identifiers = [
+    "*@example.com",
+    "jane@partner.example",
+    "john@partner.example",
+  ]
+}
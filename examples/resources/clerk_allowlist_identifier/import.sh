@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing allowlist identifier by its ID
+terraform import clerk_allowlist_identifier.example alid_2abcdefghijklmnop
@@ -0,0 +1,10 @@
+# Allow everyone with a company email address to sign up
+resource "clerk_allowlist_identifier" "employees" {
+  identifier = "*@example.com"
+}
+
+# Allow a partner and send them an invitation
+resource "clerk_allowlist_identifier" "partner" {
+  identifier = "jane@partner.example"
+  notify     = true
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import the existing blocklist of the instance
+terraform import clerk_blocklist.example blocklist
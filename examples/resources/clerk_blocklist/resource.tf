@@ -0,0 +1,5 @@
+# Block disposable email domains and known abusers, e.g. from a file
+# maintained by the security team
+resource "clerk_blocklist" "this" {
+  identifiers = toset(split("\n", trimspace(file("${path.module}/blocklist.txt"))))
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing blocklist identifier by its ID
+terraform import clerk_blocklist_identifier.example blid_2abcdefghijklmnop
@@ -0,0 +1,9 @@
+# Block sign-ups from a disposable email domain
+resource "clerk_blocklist_identifier" "disposable" {
+  identifier = "*@mailinator.com"
+}
+
+# Block a single abusive email address
+resource "clerk_blocklist_identifier" "abuser" {
+  identifier = "spammer@example.com"
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing JWT template by its ID
+terraform import clerk_jwt_template.example jtmp_2abcdefghijklmnop
@@ -0,0 +1,34 @@
+# A template for Hasura, with claims written as an object
+resource "clerk_jwt_template" "hasura" {
+  name     = "hasura"
+  lifetime = 300
+
+  claims_object = {
+    "https://hasura.io/jwt/claims" = {
+      "x-hasura-user-id"       = "{{user.id}}"
+      "x-hasura-default-role"  = "user"
+      "x-hasura-allowed-roles" = ["user"]
+    }
+  }
+}
+
+# A template signed with a custom key for another service
+variable "supabase_jwt_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "clerk_jwt_template" "supabase" {
+  name               = "supabase"
+  custom_signing_key = true
+  signing_algorithm  = "HS256"
+
+  # signing_key is write-only; bump signing_key_version to send a new key
+  signing_key         = var.supabase_jwt_secret
+  signing_key_version = 1
+
+  claims = jsonencode({
+    aud  = "authenticated"
+    role = "authenticated"
+  })
+}
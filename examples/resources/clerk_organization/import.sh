@@ -1,3 +1,6 @@
 #!/bin/bash
 # Import an existing Clerk organization by its ID
 terraform import clerk_organization.example org_2abcdefghijklmnop
+
+# Or by its slug
+terraform import clerk_organization.example slug:example-org
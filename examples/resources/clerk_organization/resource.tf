@@ -9,6 +9,9 @@ resource "clerk_organization" "with_metadata" {
   name                    = "Production Organization"
   slug                    = "prod-org"
   max_allowed_memberships = 100
+  deletion_protection     = true
+  admin_delete_enabled    = false
+  logo_path               = "${path.module}/logos/prod-org.png"
 
   public_metadata = jsonencode({
     environment = "production"
@@ -22,6 +25,36 @@ resource "clerk_organization" "with_metadata" {
   })
 }
 
+# Organization with metadata written as objects, shown key by key in plans
+resource "clerk_organization" "with_metadata_object" {
+  name = "Staging Organization"
+  slug = "staging-org"
+
+  public_metadata_object = {
+    environment = "staging"
+    regions     = ["us-west-2", "eu-central-1"]
+    tier        = "standard"
+  }
+
+  private_metadata_object = {
+    billing = {
+      customer_id = "cus_987654321"
+    }
+  }
+}
+
+# Organization whose application also writes its own metadata keys.
+# Terraform only manages the keys declared here.
+resource "clerk_organization" "shared_metadata" {
+  name                = "Shared Organization"
+  slug                = "shared-org"
+  metadata_management = "merge"
+
+  public_metadata = jsonencode({
+    tier = "premium"
+  })
+}
+
 # Minimal organization (auto-generated slug)
 resource "clerk_organization" "minimal" {
   name = "Simple Organization"
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing organization domain by organization ID and domain ID
+terraform import clerk_organization_domain.example org_2abcdefghijklmnop/orgdmn_2abcdefghijklmnop
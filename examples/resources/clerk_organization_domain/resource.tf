@@ -0,0 +1,15 @@
+resource "clerk_organization" "example" {
+  name = "Example Organization"
+  slug = "example-org"
+}
+
+# Suggest the organization to users signing up with an @example.com address
+resource "clerk_organization_domain" "example" {
+  organization_id = clerk_organization.example.id
+  name            = "example.com"
+  enrollment_mode = "automatic_suggestion"
+}
+
+output "example_domain_verified" {
+  value = clerk_organization_domain.example.verified
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing organization invitation by organization ID and invitation ID
+terraform import clerk_organization_invitation.admin org_2abcdefghijklmnop/orginv_2abcdefghijklmnop
@@ -0,0 +1,16 @@
+resource "clerk_organization" "example" {
+  name = "Example Organization"
+  slug = "example-org"
+}
+
+# Invite the customer's first admin to their new organization
+resource "clerk_organization_invitation" "admin" {
+  organization_id = clerk_organization.example.id
+  email_address   = "admin@example.com"
+  role            = "org:admin"
+  redirect_url    = "https://app.example.com/welcome"
+
+  public_metadata = jsonencode({
+    onboarding = "enterprise"
+  })
+}
@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing organization membership by organization ID and user ID
+terraform import clerk_organization_membership.admin org_2abcdefghijklmnop/user_2abcdefghijklmnop
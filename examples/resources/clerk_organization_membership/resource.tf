@@ -0,0 +1,11 @@
+resource "clerk_organization" "example" {
+  name = "Example Organization"
+  slug = "example-org"
+}
+
+# Add an existing user to the organization as an admin
+resource "clerk_organization_membership" "admin" {
+  organization_id = clerk_organization.example.id
+  user_id         = "user_2abcdefghijklmnop"
+  role            = "org:admin"
+}
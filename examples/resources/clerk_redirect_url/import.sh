@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing redirect URL by its ID
+terraform import clerk_redirect_url.example ru_2abcdefghijklmnop
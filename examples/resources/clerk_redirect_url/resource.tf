@@ -0,0 +1,11 @@
+# Allow the mobile app to receive OAuth callbacks
+resource "clerk_redirect_url" "mobile" {
+  url = "myapp://oauth-callback"
+}
+
+# Register one redirect URL per app variant
+resource "clerk_redirect_url" "variants" {
+  for_each = toset(["myapp", "myapp-beta"])
+
+  url = "${each.key}://oauth-callback"
+}
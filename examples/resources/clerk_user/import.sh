@@ -0,0 +1,3 @@
+#!/bin/bash
+# Import an existing Clerk user by its ID
+terraform import clerk_user.support_bot user_2abcdefghijklmnop
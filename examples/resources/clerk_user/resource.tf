@@ -0,0 +1,28 @@
+# Service account used by the support tooling
+resource "clerk_user" "support_bot" {
+  email_addresses = ["support-bot@example.com"]
+  username        = "support-bot"
+  first_name      = "Support"
+  last_name       = "Bot"
+  external_id     = "svc-support-bot"
+
+  public_metadata = jsonencode({
+    kind = "service-account"
+  })
+}
+
+# QA account with a password. The password is write-only and never stored
+# in state; bump password_version to rotate it.
+resource "clerk_user" "qa" {
+  email_addresses      = ["qa@example.com"]
+  phone_numbers        = ["+15555550100"]
+  password             = var.qa_password
+  password_version     = 1
+  skip_password_checks = true
+}
+
+# Organization created by a managed user
+resource "clerk_organization" "example" {
+  name       = "Example Organization"
+  created_by = clerk_user.support_bot.id
+}
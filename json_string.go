@@ -0,0 +1,223 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ basetypes.StringTypable                    = jsonStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = jsonString{}
+	_ xattr.ValidateableAttribute                = jsonString{}
+)
+
+// jsonStringType is the attribute type for strings holding a JSON document,
+// such as Clerk metadata. Values that decode to the same data are
+// semantically equal, so whitespace, key order and number formatting never
+// show up as a difference.
+type jsonStringType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable name of the type
+func (t jsonStringType) String() string {
+	return "jsonStringType"
+}
+
+// Equal reports whether the given type is also a JSON string type
+func (t jsonStringType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString wraps a string value as a JSON string value
+func (t jsonStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonString{StringValue: in}, nil
+}
+
+// ValueFromTerraform converts a Terraform value into a JSON string value
+func (t jsonStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	value, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := value.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", value)
+	}
+	return jsonString{StringValue: stringValue}, nil
+}
+
+// ValueType returns the value type of this type
+func (t jsonStringType) ValueType(_ context.Context) attr.Value {
+	return jsonString{}
+}
+
+// jsonString is a string value holding a JSON document
+type jsonString struct {
+	basetypes.StringValue
+}
+
+// jsonStringNull returns a null JSON string value
+func jsonStringNull() jsonString {
+	return jsonString{StringValue: basetypes.NewStringNull()}
+}
+
+// jsonStringValue returns a known JSON string value
+func jsonStringValue(value string) jsonString {
+	return jsonString{StringValue: basetypes.NewStringValue(value)}
+}
+
+// Type returns the type of the value
+func (v jsonString) Type(_ context.Context) attr.Type {
+	return jsonStringType{}
+}
+
+// Equal reports whether the given value is the same JSON string, byte for byte
+func (v jsonString) Equal(o attr.Value) bool {
+	other, ok := o.(jsonString)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values decode to the same data
+func (v jsonString) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(jsonString)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return jsonSemanticEquals(v.ValueString(), newValue.ValueString()), diags
+}
+
+// ValidateAttribute ensures a configured value is valid JSON
+func (v jsonString) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON, e.g. use jsonencode({ tier = \"premium\" }). Given value: "+v.ValueString(),
+		)
+	}
+}
+
+// jsonSemanticEquals reports whether two JSON documents decode to the same
+// data. Numbers are compared exactly, so large integers that only differ
+// beyond float64 precision are not equal. Documents that are not valid JSON
+// are only equal byte for byte.
+func jsonSemanticEquals(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if !json.Valid([]byte(a)) || !json.Valid([]byte(b)) {
+		return false
+	}
+	dataA, err := decodeMetadata(json.RawMessage(a))
+	if err != nil {
+		return false
+	}
+	dataB, err := decodeMetadata(json.RawMessage(b))
+	if err != nil {
+		return false
+	}
+	return jsonDataEquals(dataA, dataB)
+}
+
+// jsonDataEquals compares two values decoded by decodeMetadata. Numbers are
+// equal when they hold the same value, however they are written.
+func jsonDataEquals(a, b interface{}) bool {
+	switch a := a.(type) {
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		ratA, okA := new(big.Rat).SetString(a.String())
+		ratB, okB := new(big.Rat).SetString(b.String())
+		if !okA || !okB {
+			return a == b
+		}
+		return ratA.Cmp(ratB) == 0
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for key, value := range a {
+			other, ok := b[key]
+			if !ok || !jsonDataEquals(value, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !jsonDataEquals(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
+
+// jsonSemanticEquality returns a plan modifier that keeps the prior state
+// value when the configured JSON decodes to the same data. Semantic equality
+// is only applied by the framework when reading and applying, so without it
+// a reformatted configuration, e.g. after an import, would plan an update.
+func jsonSemanticEquality() planmodifier.String {
+	return jsonSemanticEqualityModifier{}
+}
+
+// jsonSemanticEqualityModifier implements the plan modifier
+type jsonSemanticEqualityModifier struct{}
+
+// Description returns a human-readable description of the plan modifier
+func (m jsonSemanticEqualityModifier) Description(_ context.Context) string {
+	return "Changes that do not alter the JSON data are ignored."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier
+func (m jsonSemanticEqualityModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString implements the plan modification logic
+func (m jsonSemanticEqualityModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if jsonSemanticEquals(req.StateValue.ValueString(), req.ConfigValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJSONString_semanticEquals(t *testing.T) {
+	testCases := map[string]struct {
+		a, b string
+		want bool
+	}{
+		"identical": {
+			a:    `{"tier":"premium"}`,
+			b:    `{"tier":"premium"}`,
+			want: true,
+		},
+		"whitespace": {
+			a:    "{\n  \"tier\": \"premium\"\n}",
+			b:    `{"tier":"premium"}`,
+			want: true,
+		},
+		"key order": {
+			a:    `{"a":1,"b":{"c":true,"d":null}}`,
+			b:    `{"b":{"d":null,"c":true},"a":1}`,
+			want: true,
+		},
+		"number formatting": {
+			a:    `{"seats":10}`,
+			b:    `{"seats":1.0e1}`,
+			want: true,
+		},
+		"large integers": {
+			a:    `{"id":9007199254740993}`,
+			b:    `{"id":9007199254740992}`,
+			want: false,
+		},
+		"precise decimals": {
+			a:    `{"rate":0.10000000000000000001}`,
+			b:    `{"rate":0.1}`,
+			want: false,
+		},
+		"array order": {
+			a:    `{"tags":["a","b"]}`,
+			b:    `{"tags":["b","a"]}`,
+			want: false,
+		},
+		"different value": {
+			a:    `{"tier":"premium"}`,
+			b:    `{"tier":"basic"}`,
+			want: false,
+		},
+		"invalid JSON": {
+			a:    `{"tier":`,
+			b:    `{"tier":"premium"}`,
+			want: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, diags := jsonStringValue(tc.a).StringSemanticEquals(context.Background(), jsonStringValue(tc.b))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestJSONString_validateAttribute(t *testing.T) {
+	testCases := map[string]struct {
+		value   jsonString
+		wantErr bool
+	}{
+		"null": {
+			value: jsonStringNull(),
+		},
+		"valid": {
+			value: jsonStringValue(`{"tier":"premium"}`),
+		},
+		"invalid": {
+			value:   jsonStringValue(`{tier = "premium"}`),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := &xattr.ValidateAttributeResponse{}
+			tc.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("public_metadata")}, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Errorf("expected error %t, got %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestJSONSemanticEquality(t *testing.T) {
+	testCases := map[string]struct {
+		state, config types.String
+		want          types.String
+	}{
+		"reformatted config keeps state": {
+			state:  types.StringValue(`{"b":2,"a":1}`),
+			config: types.StringValue("{\n  \"a\": 1,\n  \"b\": 2\n}"),
+			want:   types.StringValue(`{"b":2,"a":1}`),
+		},
+		"changed config is planned": {
+			state:  types.StringValue(`{"a":1}`),
+			config: types.StringValue(`{"a":2}`),
+			want:   types.StringValue(`{"a":2}`),
+		},
+		"no prior state": {
+			state:  types.StringNull(),
+			config: types.StringValue(`{"a":1}`),
+			want:   types.StringValue(`{"a":1}`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				StateValue:  tc.state,
+				ConfigValue: tc.config,
+				PlanValue:   tc.config,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+			jsonSemanticEquality().PlanModifyString(context.Background(), req, resp)
+			if !resp.PlanValue.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, resp.PlanValue)
+			}
+		})
+	}
+}
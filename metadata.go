@@ -0,0 +1,508 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// expandMetadata converts a JSON string metadata attribute into the JSON
+// sent to the Clerk API
+func expandMetadata(value jsonString, attribute string, diags *diag.Diagnostics) *json.RawMessage {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	var metadata json.RawMessage
+	if err := json.Unmarshal([]byte(value.ValueString()), &metadata); err != nil {
+		diags.AddError(
+			"Error parsing "+attribute,
+			"Could not parse "+attribute+" as JSON: "+err.Error(),
+		)
+		return nil
+	}
+	return &metadata
+}
+
+// flattenMetadata converts metadata returned by the Clerk API into a state
+// value. The prior formatting of the same data is kept by the semantic
+// equality of jsonString.
+func flattenMetadata(raw json.RawMessage) jsonString {
+	if len(raw) == 0 {
+		return jsonStringNull()
+	}
+	return jsonStringValue(string(raw))
+}
+
+// filterMetadata limits metadata returned by the Clerk API to the keys
+// present in owned, descending into objects both sides hold. It is used when
+// Terraform manages only some keys and the application writes the others.
+func filterMetadata(raw json.RawMessage, owned *json.RawMessage) (json.RawMessage, error) {
+	if len(raw) == 0 {
+		return raw, nil
+	}
+	if owned == nil {
+		return json.RawMessage("{}"), nil
+	}
+
+	data, err := decodeMetadata(raw)
+	if err != nil {
+		return nil, err
+	}
+	ownedData, err := decodeMetadata(*owned)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(filterMetadataKeys(data, ownedData))
+}
+
+// filterMetadataKeys keeps the keys of data that are present in owned
+func filterMetadataKeys(data, owned interface{}) interface{} {
+	dataObject, ok := data.(map[string]interface{})
+	if !ok {
+		return data
+	}
+	ownedObject, ok := owned.(map[string]interface{})
+	if !ok {
+		return data
+	}
+
+	result := make(map[string]interface{}, len(ownedObject))
+	for key, ownedValue := range ownedObject {
+		if value, ok := dataObject[key]; ok {
+			result[key] = filterMetadataKeys(value, ownedValue)
+		}
+	}
+	return result
+}
+
+// metadataPatch returns the metadata to send to Clerk's merge endpoint to
+// turn the keys Terraform owned before into the desired ones. Keys that are
+// no longer desired are set to null, which removes them. A nil patch means
+// nothing changes.
+func metadataPatch(prior, desired *json.RawMessage) (*json.RawMessage, error) {
+	var priorData, desiredData interface{} = map[string]interface{}{}, map[string]interface{}{}
+	var err error
+	if prior != nil {
+		if priorData, err = decodeMetadata(*prior); err != nil {
+			return nil, err
+		}
+	}
+	if desired != nil {
+		if desiredData, err = decodeMetadata(*desired); err != nil {
+			return nil, err
+		}
+	}
+
+	patch, ok := metadataPatchKeys(priorData, desiredData).(map[string]interface{})
+	if ok && len(patch) == 0 {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	metadata := json.RawMessage(encoded)
+	return &metadata, nil
+}
+
+// metadataPatchKeys builds the merge patch between two decoded values
+func metadataPatchKeys(prior, desired interface{}) interface{} {
+	priorObject, priorOK := prior.(map[string]interface{})
+	desiredObject, desiredOK := desired.(map[string]interface{})
+	if !priorOK || !desiredOK {
+		return desired
+	}
+
+	patch := make(map[string]interface{})
+	for key, desiredValue := range desiredObject {
+		priorValue, ok := priorObject[key]
+		if !ok {
+			patch[key] = desiredValue
+			continue
+		}
+		if reflect.DeepEqual(priorValue, desiredValue) {
+			continue
+		}
+		patch[key] = metadataPatchKeys(priorValue, desiredValue)
+	}
+	for key := range priorObject {
+		if _, ok := desiredObject[key]; !ok {
+			patch[key] = nil
+		}
+	}
+	return patch
+}
+
+// decodeMetadata decodes metadata, keeping numbers exactly as written
+func decodeMetadata(raw json.RawMessage) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// expandMetadataObject converts an object-typed metadata attribute into the
+// JSON sent to the Clerk API
+func expandMetadataObject(value types.Dynamic, attribute string, diags *diag.Diagnostics) *json.RawMessage {
+	if value.IsNull() || value.IsUnknown() || value.IsUnderlyingValueNull() || value.IsUnderlyingValueUnknown() {
+		return nil
+	}
+	data, err := dynamicToJSON(value.UnderlyingValue())
+	if err == nil {
+		var encoded []byte
+		encoded, err = json.Marshal(data)
+		if err == nil {
+			metadata := json.RawMessage(encoded)
+			return &metadata
+		}
+	}
+	diags.AddError(
+		"Error converting "+attribute,
+		"Could not convert "+attribute+" to JSON: "+err.Error(),
+	)
+	return nil
+}
+
+// flattenMetadataObject converts metadata returned by the Clerk API into an
+// object-typed state value, keeping the prior value when both hold the same
+// data so that list and map values written in configuration are not
+// replaced by tuples and objects.
+func flattenMetadataObject(raw json.RawMessage, prior types.Dynamic, attribute string, diags *diag.Diagnostics) types.Dynamic {
+	if len(raw) == 0 {
+		return types.DynamicNull()
+	}
+	if metadata := expandMetadataObject(prior, attribute, &diag.Diagnostics{}); metadata != nil {
+		if jsonSemanticEquals(string(*metadata), string(raw)) {
+			return prior
+		}
+	}
+
+	data, err := decodeMetadata(raw)
+	if err != nil {
+		diags.AddError(
+			"Error parsing "+attribute,
+			"Could not parse "+attribute+" returned by Clerk: "+err.Error(),
+		)
+		return prior
+	}
+	result, err := jsonToDynamic(data)
+	if err != nil {
+		diags.AddError(
+			"Error converting "+attribute,
+			"Could not convert "+attribute+" returned by Clerk: "+err.Error(),
+		)
+		return prior
+	}
+	return types.DynamicValue(result)
+}
+
+// validateMetadataObject checks that an object-typed metadata attribute holds
+// an object, since Clerk only accepts JSON objects as metadata
+func validateMetadataObject(value types.Dynamic, attributePath path.Path, diags *diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() || value.IsUnderlyingValueUnknown() {
+		return
+	}
+	switch value.UnderlyingValue().(type) {
+	case types.Object, types.Map:
+		return
+	}
+	diags.AddAttributeError(
+		attributePath,
+		"Invalid Metadata",
+		"Metadata must be an object, e.g. { tier = \"premium\" }.",
+	)
+}
+
+// dynamicToJSON converts a Terraform value into a value that encoding/json
+// can marshal
+func dynamicToJSON(value attr.Value) (interface{}, error) {
+	if value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("value is not known yet")
+	}
+
+	switch v := value.(type) {
+	case types.Dynamic:
+		return dynamicToJSON(v.UnderlyingValue())
+	case types.String:
+		return v.ValueString(), nil
+	case types.Bool:
+		return v.ValueBool(), nil
+	case types.Number:
+		return json.Number(v.ValueBigFloat().Text('g', -1)), nil
+	case types.Int64:
+		return v.ValueInt64(), nil
+	case types.Float64:
+		return v.ValueFloat64(), nil
+	case types.Object:
+		return attributesToJSON(v.Attributes())
+	case types.Map:
+		return attributesToJSON(v.Elements())
+	case types.List:
+		return elementsToJSON(v.Elements())
+	case types.Set:
+		return elementsToJSON(v.Elements())
+	case types.Tuple:
+		return elementsToJSON(v.Elements())
+	}
+	return nil, fmt.Errorf("unsupported value type %s", value.Type(context.Background()))
+}
+
+// attributesToJSON converts the attributes of an object or map
+func attributesToJSON(attributes map[string]attr.Value) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(attributes))
+	for name, value := range attributes {
+		converted, err := dynamicToJSON(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		result[name] = converted
+	}
+	return result, nil
+}
+
+// elementsToJSON converts the elements of a list, set or tuple
+func elementsToJSON(elements []attr.Value) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(elements))
+	for i, value := range elements {
+		converted, err := dynamicToJSON(value)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		result = append(result, converted)
+	}
+	return result, nil
+}
+
+// jsonToDynamic converts a decoded JSON value into the Terraform value HCL
+// would produce for the same literal: objects become objects and arrays
+// become tuples. Numbers must be decoded as json.Number.
+func jsonToDynamic(data interface{}) (attr.Value, error) {
+	switch v := data.(type) {
+	case nil:
+		return types.DynamicNull(), nil
+	case string:
+		return types.StringValue(v), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case json.Number:
+		number, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, err
+		}
+		return types.NumberValue(number), nil
+	case map[string]interface{}:
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		attributeTypes := make(map[string]attr.Type, len(v))
+		attributes := make(map[string]attr.Value, len(v))
+		for _, name := range names {
+			value, err := jsonToDynamic(v[name])
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			attributeTypes[name] = value.Type(context.Background())
+			attributes[name] = value
+		}
+		object, diags := types.ObjectValue(attributeTypes, attributes)
+		if diags.HasError() {
+			return nil, fmt.Errorf("could not build object: %v", diags)
+		}
+		return object, nil
+	case []interface{}:
+		elementTypes := make([]attr.Type, 0, len(v))
+		elements := make([]attr.Value, 0, len(v))
+		for i, item := range v {
+			value, err := jsonToDynamic(item)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			elementTypes = append(elementTypes, value.Type(context.Background()))
+			elements = append(elements, value)
+		}
+		tuple, diags := types.TupleValue(elementTypes, elements)
+		if diags.HasError() {
+			return nil, fmt.Errorf("could not build tuple: %v", diags)
+		}
+		return tuple, nil
+	}
+	return nil, fmt.Errorf("unsupported JSON value %T", data)
+}
+
+// nullWhenUnconfigured returns a plan modifier that plans a computed metadata
+// attribute as null while it is not set in the configuration, so that
+// Terraform leaves the metadata to other clients instead of owning it.
+func nullWhenUnconfigured() planmodifier.String {
+	return nullWhenUnconfiguredModifier{}
+}
+
+// nullWhenUnconfiguredModifier implements the plan modifier
+type nullWhenUnconfiguredModifier struct{}
+
+// Description returns a human-readable description of the plan modifier
+func (m nullWhenUnconfiguredModifier) Description(_ context.Context) string {
+	return "The value is null while the attribute is not configured."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier
+func (m nullWhenUnconfiguredModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString implements the plan modification logic
+func (m nullWhenUnconfiguredModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.ConfigValue.IsNull() {
+		resp.PlanValue = types.StringNull()
+	}
+}
+
+// Private state keys. managedMetadataKey lists the metadata attributes
+// Terraform set, so that removing one from the configuration clears it once
+// while metadata Terraform never set is left alone. importedKey marks a
+// resource read for the first time after an import.
+const (
+	managedMetadataKey = "managed_metadata"
+	importedKey        = "imported"
+)
+
+// privateState is the private state data of a resource
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getManagedMetadata returns the metadata attributes Terraform manages
+func getManagedMetadata(ctx context.Context, private privateState) (map[string]bool, diag.Diagnostics) {
+	managed := make(map[string]bool)
+	data, diags := private.GetKey(ctx, managedMetadataKey)
+	if diags.HasError() || len(data) == 0 {
+		return managed, diags
+	}
+
+	var attributes []string
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		diags.AddError(
+			"Error reading private state",
+			"Could not parse the managed metadata attributes: "+err.Error(),
+		)
+		return managed, diags
+	}
+	for _, attribute := range attributes {
+		managed[attribute] = true
+	}
+	return managed, diags
+}
+
+// setManagedMetadata records the metadata attributes Terraform manages
+func setManagedMetadata(ctx context.Context, private privateState, managed map[string]bool) diag.Diagnostics {
+	attributes := make([]string, 0, len(managed))
+	for attribute, ok := range managed {
+		if ok {
+			attributes = append(attributes, attribute)
+		}
+	}
+	if len(attributes) == 0 {
+		return private.SetKey(ctx, managedMetadataKey, nil)
+	}
+	sort.Strings(attributes)
+
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error writing private state",
+			"Could not encode the managed metadata attributes: "+err.Error(),
+		)
+		return diags
+	}
+	return private.SetKey(ctx, managedMetadataKey, data)
+}
+
+// refreshManagedMetadata returns the metadata attributes Terraform manages.
+// After an import it adopts the attributes holding metadata in Clerk, so
+// that the configuration has to match them like any other attribute.
+func refreshManagedMetadata(ctx context.Context, private privateState, metadata map[string]json.RawMessage) (map[string]bool, diag.Diagnostics) {
+	imported, diags := private.GetKey(ctx, importedKey)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if len(imported) == 0 {
+		managed, d := getManagedMetadata(ctx, private)
+		diags.Append(d...)
+		return managed, diags
+	}
+
+	managed := make(map[string]bool, len(metadata))
+	for attribute, raw := range metadata {
+		managed[attribute] = hasMetadata(raw)
+	}
+	diags.Append(private.SetKey(ctx, importedKey, nil)...)
+	diags.Append(setManagedMetadata(ctx, private, managed)...)
+	return managed, diags
+}
+
+// markImported records that a resource was just imported
+func markImported(ctx context.Context, private privateState) diag.Diagnostics {
+	return private.SetKey(ctx, importedKey, []byte("true"))
+}
+
+// hasMetadata reports whether metadata returned by the Clerk API holds any
+// data
+func hasMetadata(raw json.RawMessage) bool {
+	if len(raw) == 0 {
+		return false
+	}
+	data, err := decodeMetadata(raw)
+	if err != nil {
+		return true
+	}
+	if object, ok := data.(map[string]interface{}); ok {
+		return len(object) > 0
+	}
+	return data != nil
+}
+
+// refreshMetadata returns the prior value of a metadata attribute to refresh
+// from Clerk: null when Terraform does not manage the attribute, and the
+// metadata read from Clerk when the attribute was just adopted on import.
+func refreshMetadata(prior jsonString, raw json.RawMessage, managed bool) jsonString {
+	switch {
+	case !managed:
+		return jsonStringNull()
+	case prior.IsNull():
+		return flattenMetadata(raw)
+	}
+	return prior
+}
+
+// clearMetadata returns the metadata to send to Clerk for an attribute,
+// clearing it when Terraform managed the attribute before it was removed
+// from the configuration
+func clearMetadata(metadata *json.RawMessage, managed bool) *json.RawMessage {
+	if metadata != nil || !managed {
+		return metadata
+	}
+	empty := json.RawMessage("{}")
+	return &empty
+}
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMetadataObject_roundTrip(t *testing.T) {
+	testCases := map[string]string{
+		"empty":    `{}`,
+		"scalars":  `{"enabled":true,"seats":5,"ratio":0.25,"tier":"premium"}`,
+		"nested":   `{"billing":{"plan":"pro","seats":10},"tags":["a","b",3]}`,
+		"null":     `{"deleted_at":null}`,
+		"large":    `{"id":12345678901234567890}`,
+		"unicode":  `{"name":"Ünïcödé <&>"}`,
+		"emptyArr": `{"tags":[]}`,
+	}
+
+	for name, raw := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			value := flattenMetadataObject(json.RawMessage(raw), types.DynamicNull(), "metadata", &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected error flattening: %v", diags)
+			}
+
+			metadata := expandMetadataObject(value, "metadata", &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected error expanding: %v", diags)
+			}
+
+			if !jsonSemanticEquals(raw, string(*metadata)) {
+				t.Errorf("expected %s, got %s", raw, string(*metadata))
+			}
+		})
+	}
+}
+
+func TestFlattenMetadataObject_keepsPrior(t *testing.T) {
+	// A list written in configuration must not be replaced by the tuple
+	// decoded from the same JSON
+	prior := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"tags": types.ListType{ElemType: types.StringType}},
+		map[string]attr.Value{"tags": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")})},
+	))
+
+	var diags diag.Diagnostics
+
+	value := flattenMetadataObject(json.RawMessage(`{"tags":["a"]}`), prior, "metadata", &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !value.Equal(prior) {
+		t.Errorf("expected prior value to be kept, got %s", value)
+	}
+
+	value = flattenMetadataObject(json.RawMessage(`{"tags":["b"]}`), prior, "metadata", &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if value.Equal(prior) {
+		t.Errorf("expected changed metadata to replace the prior value")
+	}
+}
+
+func TestValidateMetadataObject(t *testing.T) {
+	testCases := map[string]struct {
+		value   types.Dynamic
+		wantErr bool
+	}{
+		"null": {
+			value: types.DynamicNull(),
+		},
+		"unknown": {
+			value: types.DynamicUnknown(),
+		},
+		"object": {
+			value: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{"tier": types.StringType},
+				map[string]attr.Value{"tier": types.StringValue("premium")},
+			)),
+		},
+		"map": {
+			value: types.DynamicValue(types.MapValueMust(types.StringType, map[string]attr.Value{"tier": types.StringValue("premium")})),
+		},
+		"string": {
+			value:   types.DynamicValue(types.StringValue(`{"tier":"premium"}`)),
+			wantErr: true,
+		},
+		"tuple": {
+			value:   types.DynamicValue(types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("a")})),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			validateMetadataObject(tc.value, path.Root("public_metadata_object"), &diags)
+			if diags.HasError() != tc.wantErr {
+				t.Errorf("expected error %t, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestFilterMetadata(t *testing.T) {
+	testCases := map[string]struct {
+		raw   string
+		owned *json.RawMessage
+		want  string
+	}{
+		"keeps owned keys": {
+			raw:   `{"tier":"premium","billing_status":"active"}`,
+			owned: rawMetadata(`{"tier":"basic"}`),
+			want:  `{"tier":"premium"}`,
+		},
+		"descends into owned objects": {
+			raw:   `{"billing":{"plan":"pro","status":"active"},"seats":10}`,
+			owned: rawMetadata(`{"billing":{"plan":"pro"}}`),
+			want:  `{"billing":{"plan":"pro"}}`,
+		},
+		"owned key removed outside Terraform": {
+			raw:   `{"billing_status":"active"}`,
+			owned: rawMetadata(`{"tier":"basic"}`),
+			want:  `{}`,
+		},
+		"owns nothing": {
+			raw:  `{"billing_status":"active"}`,
+			want: `{}`,
+		},
+		"value changed type": {
+			raw:   `{"billing":"none"}`,
+			owned: rawMetadata(`{"billing":{"plan":"pro"}}`),
+			want:  `{"billing":"none"}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := filterMetadata(json.RawMessage(tc.raw), tc.owned)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !jsonSemanticEquals(string(got), tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMetadataPatch(t *testing.T) {
+	testCases := map[string]struct {
+		prior, desired *json.RawMessage
+		want           string
+	}{
+		"no prior keys": {
+			desired: rawMetadata(`{"tier":"premium"}`),
+			want:    `{"tier":"premium"}`,
+		},
+		"unchanged": {
+			prior:   rawMetadata(`{"tier":"premium","seats":10}`),
+			desired: rawMetadata(`{"seats":10,"tier":"premium"}`),
+		},
+		"changed and removed keys": {
+			prior:   rawMetadata(`{"tier":"premium","region":"eu"}`),
+			desired: rawMetadata(`{"tier":"enterprise"}`),
+			want:    `{"tier":"enterprise","region":null}`,
+		},
+		"nested keys": {
+			prior:   rawMetadata(`{"billing":{"plan":"pro","annual":true}}`),
+			desired: rawMetadata(`{"billing":{"plan":"pro"}}`),
+			want:    `{"billing":{"annual":null}}`,
+		},
+		"no desired keys": {
+			prior: rawMetadata(`{"tier":"premium"}`),
+			want:  `{"tier":null}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := metadataPatch(tc.prior, tc.desired)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.want == "" {
+				if got != nil {
+					t.Errorf("expected no patch, got %s", *got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("expected %s, got no patch", tc.want)
+			}
+			if !jsonSemanticEquals(string(*got), tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, *got)
+			}
+		})
+	}
+}
+
+func TestRefreshManagedMetadata(t *testing.T) {
+	ctx := context.Background()
+	metadata := map[string]json.RawMessage{
+		"public_metadata":  json.RawMessage(`{"tier":"premium"}`),
+		"private_metadata": json.RawMessage(`{}`),
+	}
+
+	// Nothing is managed until Terraform sets it
+	private := testPrivateState{}
+	managed, diags := refreshManagedMetadata(ctx, private, metadata)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(managed) != 0 {
+		t.Errorf("expected no managed metadata, got %v", managed)
+	}
+
+	// Only metadata holding data is adopted on import
+	diags = markImported(ctx, private)
+	managed, d := refreshManagedMetadata(ctx, private, metadata)
+	diags.Append(d...)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	want := map[string]bool{"public_metadata": true, "private_metadata": false}
+	if !reflect.DeepEqual(managed, want) {
+		t.Errorf("expected %v, got %v", want, managed)
+	}
+	if _, ok := private[importedKey]; ok {
+		t.Error("expected the import marker to be removed")
+	}
+
+	// The adopted metadata stays managed on later refreshes
+	managed, diags = refreshManagedMetadata(ctx, private, metadata)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !reflect.DeepEqual(managed, map[string]bool{"public_metadata": true}) {
+		t.Errorf("expected public_metadata to stay managed, got %v", managed)
+	}
+}
+
+func TestClearMetadata(t *testing.T) {
+	if got := clearMetadata(nil, true); got == nil || string(*got) != `{}` {
+		t.Errorf("expected managed metadata to be cleared, got %v", got)
+	}
+	if got := clearMetadata(nil, false); got != nil {
+		t.Errorf("expected unmanaged metadata to be left alone, got %s", *got)
+	}
+	if got := clearMetadata(rawMetadata(`{"tier":"premium"}`), true); got == nil || string(*got) != `{"tier":"premium"}` {
+		t.Errorf("expected configured metadata to be sent, got %v", got)
+	}
+}
+
+// testPrivateState is an in-memory private state
+type testPrivateState map[string][]byte
+
+func (s testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return s[key], nil
+}
+
+func (s testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(s, key)
+	} else {
+		s[key] = value
+	}
+	return nil
+}
+
+func rawMetadata(value string) *json.RawMessage {
+	metadata := json.RawMessage(value)
+	return &metadata
+}
@@ -2,10 +2,13 @@ package main
 
 import (
 	"context"
+	"net/url"
 	"os"
+	"time"
 
 	"github.com/clerk/clerk-sdk-go/v2"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,7 +27,13 @@ type clerkProvider struct {
 
 // clerkProviderModel describes the provider data model
 type clerkProviderModel struct {
-	APIKey types.String `tfsdk:"api_key"`
+	APIKey     types.String `tfsdk:"api_key"`
+	APIURL     types.String `tfsdk:"api_url"`
+	MaxRetries types.Int64  `tfsdk:"max_retries"`
+	MinBackoff types.String `tfsdk:"min_backoff"`
+	MaxBackoff types.String `tfsdk:"max_backoff"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
 }
 
 // New returns a new provider instance
@@ -52,6 +61,30 @@ func (p *clerkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"api_url": schema.StringAttribute{
+				Description: "Base URL of the Clerk Backend API, including the version path. " +
+					"Defaults to " + clerk.APIURL + ". Can also be set via CLERK_API_URL environment variable.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a Clerk API call is retried after a rate limit (429) or server (5xx) error. " +
+					"Server errors are only retried for idempotent calls. Set to 0 to disable retries. Defaults to 3.",
+				Optional: true,
+			},
+			"min_backoff": schema.StringAttribute{
+				Description: "Initial wait between retries as a Go duration, doubled after each attempt. " +
+					"A Retry-After header sent by Clerk takes precedence. Defaults to 1s.",
+				Optional: true,
+			},
+			"max_backoff": schema.StringAttribute{
+				Description: "Maximum wait between retries as a Go duration, also applied to the Retry-After header. Defaults to 30s.",
+				Optional:    true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Default for the deletion_protection attribute of resources that support it, " +
+					"such as clerk_organization. Defaults to false.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -81,13 +114,92 @@ func (p *clerkProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	// Set the global Clerk API key
-	clerk.SetKey(apiKey)
+	// Check for a custom API URL in configuration or environment variable
+	apiURL := os.Getenv("CLERK_API_URL")
+	if !config.APIURL.IsNull() {
+		apiURL = config.APIURL.ValueString()
+	}
+
+	if apiURL == "" {
+		apiURL = clerk.APIURL
+	}
+
+	if u, err := url.Parse(apiURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			"Invalid API URL",
+			"api_url must be an absolute http or https URL such as \"https://api.clerk.com/v1\", got: "+apiURL,
+		)
+		return
+	}
+
+	// Build the retry policy applied to every API call
+	policy := retryPolicy{
+		MaxRetries: defaultMaxRetries,
+		MinBackoff: defaultMinBackoff,
+		MaxBackoff: defaultMaxBackoff,
+	}
+
+	if !config.MaxRetries.IsNull() {
+		if config.MaxRetries.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Retry Configuration",
+				"max_retries must not be negative.",
+			)
+		}
+		policy.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
 
-	client := &ClerkClient{
-		APIKey: apiKey,
+	if !config.MinBackoff.IsNull() {
+		backoff, err := time.ParseDuration(config.MinBackoff.ValueString())
+		if err != nil || backoff <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("min_backoff"),
+				"Invalid Retry Configuration",
+				"min_backoff must be a positive duration such as \"500ms\" or \"2s\", got: "+config.MinBackoff.ValueString(),
+			)
+		}
+		policy.MinBackoff = backoff
 	}
 
+	if !config.MaxBackoff.IsNull() {
+		backoff, err := time.ParseDuration(config.MaxBackoff.ValueString())
+		if err != nil || backoff <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_backoff"),
+				"Invalid Retry Configuration",
+				"max_backoff must be a positive duration such as \"30s\" or \"1m\", got: "+config.MaxBackoff.ValueString(),
+			)
+		}
+		policy.MaxBackoff = backoff
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if policy.MaxBackoff < policy.MinBackoff {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_backoff"),
+			"Invalid Retry Configuration",
+			"max_backoff must not be shorter than min_backoff.",
+		)
+		return
+	}
+
+	// Build a client for this provider instance only. The SDK's global
+	// clerk.SetKey is deliberately not used, so that aliased providers
+	// configured for different Clerk instances do not overwrite each other.
+	client := NewClerkClient(&clerk.ClientConfig{
+		BackendConfig: clerk.BackendConfig{
+			Key:        clerk.String(apiKey),
+			URL:        clerk.String(apiURL),
+			HTTPClient: newRetryHTTPClient(policy),
+		},
+	})
+	client.DeletionProtection = config.DeletionProtection.ValueBool()
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
@@ -96,10 +208,23 @@ func (p *clerkProvider) Configure(ctx context.Context, req provider.ConfigureReq
 func (p *clerkProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewOrganizationResource,
+		NewOrganizationMembershipResource,
+		NewOrganizationInvitationResource,
+		NewOrganizationDomainResource,
+		NewUserResource,
+		NewAllowlistIdentifierResource,
+		NewBlocklistIdentifierResource,
+		NewAllowlistResource,
+		NewBlocklistResource,
+		NewJWTTemplateResource,
+		NewRedirectURLResource,
 	}
 }
 
 // DataSources defines the data sources implemented in the provider
 func (p *clerkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewOrganizationDataSource,
+		NewOrganizationsDataSource,
+	}
 }
@@ -1,11 +1,19 @@
 package main
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -24,3 +32,104 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("CLERK_API_KEY must be set for acceptance tests")
 	}
 }
+
+// testAccClerkClient returns a client for making API calls outside
+// Terraform during acceptance tests, e.g. to simulate out-of-band changes.
+// The client honors CLERK_API_URL, so it calls the same endpoint as the
+// provider under test.
+func testAccClerkClient() *ClerkClient {
+	config := &clerk.ClientConfig{
+		BackendConfig: clerk.BackendConfig{
+			Key: clerk.String(os.Getenv("CLERK_API_KEY")),
+		},
+	}
+	if apiURL := os.Getenv("CLERK_API_URL"); apiURL != "" {
+		config.URL = clerk.String(apiURL)
+	}
+	return NewClerkClient(config)
+}
+
+func TestProviderConfigure_apiURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want := "/v1/organizations/org_123"; r.URL.Path != want {
+			t.Errorf("expected path %q, got %q", want, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"object":"organization","id":"org_123"}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("CLERK_API_KEY", "sk_test")
+
+	for name, tc := range map[string]struct {
+		config map[string]tftypes.Value
+		env    string
+	}{
+		"attribute": {
+			config: map[string]tftypes.Value{"api_url": tftypes.NewValue(tftypes.String, server.URL+"/v1")},
+			env:    "https://unused.example.com/v1",
+		},
+		"environment": {
+			env: server.URL + "/v1",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("CLERK_API_URL", tc.env)
+
+			client, diags := testProviderConfigure(t, tc.config)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			org, err := client.GetOrganization(context.Background(), "org_123")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if org.ID != "org_123" {
+				t.Errorf("expected organization %q, got %q", "org_123", org.ID)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_invalidAPIURL(t *testing.T) {
+	t.Setenv("CLERK_API_KEY", "sk_test")
+	t.Setenv("CLERK_API_URL", "")
+
+	for _, apiURL := range []string{"api.clerk.com/v1", "ftp://api.clerk.com/v1", "https://", "://bad"} {
+		_, diags := testProviderConfigure(t, map[string]tftypes.Value{
+			"api_url": tftypes.NewValue(tftypes.String, apiURL),
+		})
+		if !diags.HasError() {
+			t.Errorf("expected %q to be rejected", apiURL)
+		}
+	}
+}
+
+// testProviderConfigure configures the provider with the given attributes,
+// leaving the others unset, and returns the resulting client
+func testProviderConfigure(t *testing.T, attributes map[string]tftypes.Value) (*ClerkClient, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	p := New("test")()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+	return resp.ResourceData.(*ClerkClient), resp.Diagnostics
+}
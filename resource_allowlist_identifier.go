@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/allowlistidentifier"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &allowlistIdentifierResource{}
+	_ resource.ResourceWithConfigure   = &allowlistIdentifierResource{}
+	_ resource.ResourceWithImportState = &allowlistIdentifierResource{}
+)
+
+// NewAllowlistIdentifierResource is a helper function to simplify the provider implementation
+func NewAllowlistIdentifierResource() resource.Resource {
+	return &allowlistIdentifierResource{}
+}
+
+// allowlistIdentifierResource is the resource implementation
+type allowlistIdentifierResource struct {
+	client *ClerkClient
+}
+
+// allowlistIdentifierResourceModel describes the resource data model
+type allowlistIdentifierResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Identifier     types.String `tfsdk:"identifier"`
+	Notify         types.Bool   `tfsdk:"notify"`
+	IdentifierType types.String `tfsdk:"identifier_type"`
+	InvitationID   types.String `tfsdk:"invitation_id"`
+}
+
+// Metadata returns the resource type name
+func (r *allowlistIdentifierResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allowlist_identifier"
+}
+
+// Schema defines the schema for the resource
+func (r *allowlistIdentifierResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an identifier on the Clerk allowlist. While the allowlist is enabled for the instance, " +
+			"only allowlisted identifiers can sign up.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the allowlist entry.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				Description: "The email address, phone number or web3 wallet to allow. " +
+					"Use a wildcard such as *@example.com to allow a whole email domain.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notify": schema.BoolAttribute{
+				Description: "Whether to send an invitation to the identifier when it is allowlisted. " +
+					"Only applies when the identifier is added. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"identifier_type": schema.StringAttribute{
+				Description: "The type of the identifier, e.g. email_address or phone_number.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invitation_id": schema.StringAttribute{
+				Description: "The ID of the invitation sent when notify was set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *allowlistIdentifierResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *ClerkClient, got something else. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state
+func (r *allowlistIdentifierResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan allowlistIdentifierResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add the identifier to the allowlist
+	identifier, err := r.client.CreateAllowlistIdentifier(ctx, &allowlistidentifier.CreateParams{
+		Identifier: clerk.String(plan.Identifier.ValueString()),
+		Notify:     clerk.Bool(plan.Notify.ValueBool()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating allowlist identifier",
+			"Could not add "+plan.Identifier.ValueString()+" to the allowlist: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	plan.ID = types.StringValue(identifier.ID)
+	plan.setIdentifier(identifier)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data
+func (r *allowlistIdentifierResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state allowlistIdentifierResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up the identifier on the allowlist
+	identifier, err := r.client.GetAllowlistIdentifier(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading allowlist identifier",
+			"Could not read allowlist identifier ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// The identifier was removed outside Terraform, so let Terraform plan to add it again
+	if identifier == nil {
+		tflog.Warn(ctx, "Allowlist identifier not found, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state with refreshed values
+	state.setIdentifier(identifier)
+
+	// notify is not returned by the API, e.g. after an import
+	if state.Notify.IsNull() {
+		state.Notify = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success
+func (r *allowlistIdentifierResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan allowlistIdentifierResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only notify can change in place, and it has no effect once the
+	// identifier has been added, so there is nothing to send to Clerk
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success
+func (r *allowlistIdentifierResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state allowlistIdentifierResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the identifier from the allowlist
+	err := r.client.DeleteAllowlistIdentifier(ctx, state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting allowlist identifier",
+			"Could not delete allowlist identifier ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform state
+func (r *allowlistIdentifierResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setIdentifier copies the attributes returned by the Clerk API into the model
+func (m *allowlistIdentifierResourceModel) setIdentifier(identifier *clerk.AllowlistIdentifier) {
+	// Clerk stores email addresses in lower case, so keep the configured
+	// spelling to avoid a perpetual diff
+	if !strings.EqualFold(m.Identifier.ValueString(), identifier.Identifier) {
+		m.Identifier = types.StringValue(identifier.Identifier)
+	}
+	m.IdentifierType = types.StringValue(identifier.IdentifierType)
+	m.InvitationID = types.StringPointerValue(identifier.InvitationID)
+}
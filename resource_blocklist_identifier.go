@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/blocklistidentifier"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &blocklistIdentifierResource{}
+	_ resource.ResourceWithConfigure   = &blocklistIdentifierResource{}
+	_ resource.ResourceWithImportState = &blocklistIdentifierResource{}
+)
+
+// NewBlocklistIdentifierResource is a helper function to simplify the provider implementation
+func NewBlocklistIdentifierResource() resource.Resource {
+	return &blocklistIdentifierResource{}
+}
+
+// blocklistIdentifierResource is the resource implementation
+type blocklistIdentifierResource struct {
+	client *ClerkClient
+}
+
+// blocklistIdentifierResourceModel describes the resource data model
+type blocklistIdentifierResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Identifier     types.String `tfsdk:"identifier"`
+	IdentifierType types.String `tfsdk:"identifier_type"`
+}
+
+// Metadata returns the resource type name
+func (r *blocklistIdentifierResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blocklist_identifier"
+}
+
+// Schema defines the schema for the resource
+func (r *blocklistIdentifierResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an identifier on the Clerk blocklist. While the blocklist is enabled for the instance, " +
+			"blocklisted identifiers cannot sign up.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the blocklist entry.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				Description: "The email address, phone number or web3 wallet to block. " +
+					"Use a wildcard such as *@example.com to block a whole email domain.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identifier_type": schema.StringAttribute{
+				Description: "The type of the identifier, e.g. email_address or phone_number.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *blocklistIdentifierResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *ClerkClient, got something else. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state
+func (r *blocklistIdentifierResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan blocklistIdentifierResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add the identifier to the blocklist
+	identifier, err := r.client.CreateBlocklistIdentifier(ctx, &blocklistidentifier.CreateParams{
+		Identifier: clerk.String(plan.Identifier.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating blocklist identifier",
+			"Could not add "+plan.Identifier.ValueString()+" to the blocklist: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	plan.ID = types.StringValue(identifier.ID)
+	plan.setIdentifier(identifier)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data
+func (r *blocklistIdentifierResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state blocklistIdentifierResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up the identifier on the blocklist
+	identifier, err := r.client.GetBlocklistIdentifier(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading blocklist identifier",
+			"Could not read blocklist identifier ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// The identifier was removed outside Terraform, so let Terraform plan to add it again
+	if identifier == nil {
+		tflog.Warn(ctx, "Blocklist identifier not found, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state with refreshed values
+	state.setIdentifier(identifier)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success
+func (r *blocklistIdentifierResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan blocklistIdentifierResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing the identifier forces a new entry, so there is nothing to
+	// send to Clerk
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success
+func (r *blocklistIdentifierResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state blocklistIdentifierResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the identifier from the blocklist
+	err := r.client.DeleteBlocklistIdentifier(ctx, state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting blocklist identifier",
+			"Could not delete blocklist identifier ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform state
+func (r *blocklistIdentifierResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setIdentifier copies the attributes returned by the Clerk API into the model
+func (m *blocklistIdentifierResourceModel) setIdentifier(identifier *clerk.BlocklistIdentifier) {
+	// Clerk stores email addresses in lower case, so keep the configured
+	// spelling to avoid a perpetual diff
+	if !strings.EqualFold(m.Identifier.ValueString(), identifier.Identifier) {
+		m.Identifier = types.StringValue(identifier.Identifier)
+	}
+	m.IdentifierType = types.StringValue(identifier.IdentifierType)
+}
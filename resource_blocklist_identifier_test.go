@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlocklistIdentifierResource(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	email := fmt.Sprintf("tf-block-%s@example.com", rString)
+	domain := fmt.Sprintf("*@tf-block-%s.example.com", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBlocklistIdentifierResourceConfig(email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_blocklist_identifier.test", "identifier", email),
+					resource.TestCheckResourceAttr("clerk_blocklist_identifier.test", "identifier_type", "email_address"),
+					resource.TestCheckResourceAttrSet("clerk_blocklist_identifier.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "clerk_blocklist_identifier.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Changing the identifier replaces the entry
+			{
+				Config: testAccBlocklistIdentifierResourceConfig(domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_blocklist_identifier.test", "identifier", domain),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlocklistIdentifierResourceConfig(identifier string) string {
+	return fmt.Sprintf(`
+resource "clerk_blocklist_identifier" "test" {
+  identifier = %[1]q
+}
+`, identifier)
+}
@@ -0,0 +1,492 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/allowlistidentifier"
+	"github.com/clerk/clerk-sdk-go/v2/blocklistidentifier"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// identifierListConcurrency is the number of identifiers added or removed
+// at the same time. Rate limited calls are retried by the HTTP client.
+const identifierListConcurrency = 5
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &identifierListResource{}
+	_ resource.ResourceWithConfigure   = &identifierListResource{}
+	_ resource.ResourceWithImportState = &identifierListResource{}
+)
+
+// identifierListKind describes one of the instance-wide identifier lists, the
+// allowlist or the blocklist
+type identifierListKind struct {
+	// name is the name of the list, used for the resource type and ID
+	name string
+	// description completes the resource description
+	description string
+
+	// list returns the IDs of all identifiers on the list, by identifier
+	list func(ctx context.Context, client *ClerkClient) (map[string]string, error)
+	// add adds an identifier to the list
+	add func(ctx context.Context, client *ClerkClient, identifier string) error
+	// remove removes an identifier from the list by ID
+	remove func(ctx context.Context, client *ClerkClient, id string) error
+}
+
+// allowlist is the instance allowlist
+var allowlist = identifierListKind{
+	name:        "allowlist",
+	description: "While the allowlist is enabled for the instance, only allowlisted identifiers can sign up.",
+	list: func(ctx context.Context, client *ClerkClient) (map[string]string, error) {
+		identifiers, err := client.ListAllowlistIdentifiers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := make(map[string]string, len(identifiers))
+		for _, identifier := range identifiers {
+			ids[identifier.Identifier] = identifier.ID
+		}
+		return ids, nil
+	},
+	add: func(ctx context.Context, client *ClerkClient, identifier string) error {
+		_, err := client.CreateAllowlistIdentifier(ctx, &allowlistidentifier.CreateParams{
+			Identifier: clerk.String(identifier),
+		})
+		return err
+	},
+	remove: func(ctx context.Context, client *ClerkClient, id string) error {
+		return client.DeleteAllowlistIdentifier(ctx, id)
+	},
+}
+
+// blocklist is the instance blocklist
+var blocklist = identifierListKind{
+	name:        "blocklist",
+	description: "While the blocklist is enabled for the instance, blocklisted identifiers cannot sign up.",
+	list: func(ctx context.Context, client *ClerkClient) (map[string]string, error) {
+		identifiers, err := client.ListBlocklistIdentifiers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := make(map[string]string, len(identifiers))
+		for _, identifier := range identifiers {
+			ids[identifier.Identifier] = identifier.ID
+		}
+		return ids, nil
+	},
+	add: func(ctx context.Context, client *ClerkClient, identifier string) error {
+		_, err := client.CreateBlocklistIdentifier(ctx, &blocklistidentifier.CreateParams{
+			Identifier: clerk.String(identifier),
+		})
+		return err
+	},
+	remove: func(ctx context.Context, client *ClerkClient, id string) error {
+		return client.DeleteBlocklistIdentifier(ctx, id)
+	},
+}
+
+// NewAllowlistResource is a helper function to simplify the provider implementation
+func NewAllowlistResource() resource.Resource {
+	return &identifierListResource{list: allowlist}
+}
+
+// NewBlocklistResource is a helper function to simplify the provider implementation
+func NewBlocklistResource() resource.Resource {
+	return &identifierListResource{list: blocklist}
+}
+
+// identifierListResource is the resource implementation, shared by the
+// allowlist and the blocklist
+type identifierListResource struct {
+	client *ClerkClient
+	list   identifierListKind
+}
+
+// identifierListResourceModel describes the resource data model
+type identifierListResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Identifiers types.Set    `tfsdk:"identifiers"`
+}
+
+// Metadata returns the resource type name
+func (r *identifierListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.list.name
+}
+
+// Schema defines the schema for the resource
+func (r *identifierListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages every identifier on the Clerk " + r.list.name + " of the instance. " + r.list.description + " " +
+			"Identifiers that are not configured are removed, so do not combine it with clerk_" + r.list.name + "_identifier.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Always " + r.list.name + ", as there is a single " + r.list.name + " per instance.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"identifiers": schema.SetAttribute{
+				Description: "The email addresses, phone numbers and web3 wallets on the " + r.list.name + ". " +
+					"Use a wildcard such as *@example.com to match a whole email domain.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					uniqueIdentifiers(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *identifierListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *ClerkClient, got something else. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state
+func (r *identifierListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan identifierListResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(r.list.name)
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &resp.State)...)
+}
+
+// Read refreshes the Terraform state with the latest data
+func (r *identifierListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state identifierListResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.list.list(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading "+r.list.name,
+			"Could not list the "+r.list.name+" identifiers: "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with refreshed values
+	resp.Diagnostics.Append(state.setIdentifiers(ctx, current)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success
+func (r *identifierListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan identifierListResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &resp.State)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success
+func (r *identifierListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state identifierListResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.list.list(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting "+r.list.name,
+			"Could not list the "+r.list.name+" identifiers: "+err.Error(),
+		)
+		return
+	}
+
+	// Only remove the listed identifiers managed by this resource
+	var managed []string
+	resp.Diagnostics.Append(state.Identifiers.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listed := make([]string, 0, len(current))
+	for identifier := range current {
+		listed = append(listed, identifier)
+	}
+	remove := filterIdentifiers(listed, managed)
+	err = forEachConcurrently(remove, identifierListConcurrency, func(identifier string) error {
+		if err := r.list.remove(ctx, r.client, current[identifier]); err != nil && !isNotFound(err) {
+			return fmt.Errorf("%s: %w", identifier, err)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting "+r.list.name,
+			"Could not remove identifiers from the "+r.list.name+": "+err.Error(),
+		)
+	}
+}
+
+// ImportState imports an existing resource into Terraform state
+func (r *identifierListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != r.list.name {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier "+r.list.name+", as there is a single "+r.list.name+" per instance. Got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.list.name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identifiers"), types.SetValueMust(types.StringType, nil))...)
+}
+
+// apply adds and removes identifiers until the list matches the plan, then
+// saves the result into state. When some calls fail, the identifiers that
+// are actually on the list are saved instead, so the next plan retries them.
+func (r *identifierListResource) apply(ctx context.Context, plan *identifierListResourceModel, state stateSetter) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var desired []string
+	diags.Append(plan.Identifiers.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := r.list.list(ctx, r.client)
+	if err != nil {
+		diags.AddError(
+			"Error updating "+r.list.name,
+			"Could not list the "+r.list.name+" identifiers: "+err.Error(),
+		)
+		return diags
+	}
+
+	add, remove := identifierListDelta(desired, current)
+
+	err = forEachConcurrently(remove, identifierListConcurrency, func(identifier string) error {
+		if err := r.list.remove(ctx, r.client, current[identifier]); err != nil && !isNotFound(err) {
+			return fmt.Errorf("%s: %w", identifier, err)
+		}
+		return nil
+	})
+	if err == nil {
+		err = forEachConcurrently(add, identifierListConcurrency, func(identifier string) error {
+			if err := r.list.add(ctx, r.client, identifier); err != nil {
+				return fmt.Errorf("%s: %w", identifier, err)
+			}
+			return nil
+		})
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Error updating "+r.list.name,
+			"Could not update the "+r.list.name+": "+err.Error(),
+		)
+
+		// Record what actually changed
+		if current, listErr := r.list.list(ctx, r.client); listErr == nil {
+			diags.Append(plan.setIdentifiers(ctx, current)...)
+			diags.Append(state.Set(ctx, plan)...)
+		}
+		return diags
+	}
+
+	// Save data into Terraform state
+	diags.Append(state.Set(ctx, plan)...)
+	return diags
+}
+
+// stateSetter is implemented by the state of create and update responses
+type stateSetter interface {
+	Set(ctx context.Context, val interface{}) diag.Diagnostics
+}
+
+// setIdentifiers replaces the identifiers in the model with the ones on the
+// list. Clerk stores email addresses in lower case, so the spelling already
+// in the model is kept for identifiers that only differ in case.
+func (m *identifierListResourceModel) setIdentifiers(ctx context.Context, current map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var prior []string
+	if !m.Identifiers.IsNull() && !m.Identifiers.IsUnknown() {
+		diags.Append(m.Identifiers.ElementsAs(ctx, &prior, false)...)
+	}
+
+	identifiers := make([]string, 0, len(current))
+	for identifier := range current {
+		for _, p := range prior {
+			if strings.EqualFold(p, identifier) {
+				identifier = p
+				break
+			}
+		}
+		identifiers = append(identifiers, identifier)
+	}
+	sort.Strings(identifiers)
+
+	value, d := types.SetValueFrom(ctx, types.StringType, identifiers)
+	diags.Append(d...)
+	m.Identifiers = value
+	return diags
+}
+
+// identifierListDelta returns the desired identifiers missing from the
+// current list, and the listed identifiers that are no longer desired.
+// Identifiers are compared without regard to case.
+func identifierListDelta(desired []string, current map[string]string) (add, remove []string) {
+	wanted := make(map[string]bool, len(desired))
+	for _, identifier := range desired {
+		wanted[strings.ToLower(identifier)] = true
+	}
+	listed := make(map[string]bool, len(current))
+	for identifier := range current {
+		listed[strings.ToLower(identifier)] = true
+		if !wanted[strings.ToLower(identifier)] {
+			remove = append(remove, identifier)
+		}
+	}
+	for _, identifier := range desired {
+		if !listed[strings.ToLower(identifier)] {
+			add = append(add, identifier)
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+	return add, remove
+}
+
+// uniqueIdentifiers returns a validator that rejects identifiers configured
+// twice with different case. Clerk would refuse the second one as a
+// duplicate, since it stores email addresses in lower case.
+func uniqueIdentifiers() validator.Set {
+	return uniqueIdentifiersValidator{}
+}
+
+// uniqueIdentifiersValidator implements the unique identifiers validator
+type uniqueIdentifiersValidator struct{}
+
+// Description returns a human-readable description of the validator
+func (v uniqueIdentifiersValidator) Description(_ context.Context) string {
+	return "identifiers must be unique, ignoring case"
+}
+
+// MarkdownDescription returns a markdown description of the validator
+func (v uniqueIdentifiersValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateSet implements the validation logic
+func (v uniqueIdentifiersValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := make(map[string]string, len(req.ConfigValue.Elements()))
+	for _, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		identifier := value.ValueString()
+		if prior, ok := seen[strings.ToLower(identifier)]; ok {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Duplicate Identifier",
+				"Identifiers are compared without regard to case, so "+prior+" and "+identifier+" are the same identifier. "+
+					"Remove one of them.",
+			)
+			continue
+		}
+		seen[strings.ToLower(identifier)] = identifier
+	}
+}
+
+// filterIdentifiers returns the identifiers that are also in keep, compared
+// without regard to case
+func filterIdentifiers(identifiers, keep []string) []string {
+	kept := make(map[string]bool, len(keep))
+	for _, identifier := range keep {
+		kept[strings.ToLower(identifier)] = true
+	}
+	var filtered []string
+	for _, identifier := range identifiers {
+		if kept[strings.ToLower(identifier)] {
+			filtered = append(filtered, identifier)
+		}
+	}
+	return filtered
+}
+
+// forEachConcurrently calls fn for every item, with at most limit calls
+// running at the same time, and returns all errors joined together
+func forEachConcurrently(items []string, limit int, fn func(item string) error) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	sem := make(chan struct{}, limit)
+	for _, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(item string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fn(item); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(item)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
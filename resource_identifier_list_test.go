@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAllowlistResource(t *testing.T) {
+	testAccIdentifierListResource(t, "allowlist")
+}
+
+func TestAccBlocklistResource(t *testing.T) {
+	testAccIdentifierListResource(t, "blocklist")
+}
+
+func testAccIdentifierListResource(t *testing.T, name string) {
+	// The resource removes every identifier it does not manage, including
+	// the entries of other tests, so it only runs on a dedicated instance
+	if os.Getenv("CLERK_TEST_IDENTIFIER_LISTS") == "" {
+		t.Skip("CLERK_TEST_IDENTIFIER_LISTS must be set to run " + name + " acceptance tests, which clear the " + name + " of the test instance")
+	}
+
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	first := fmt.Sprintf("tf-%s-%s-1@example.com", name, rString)
+	second := fmt.Sprintf("tf-%s-%s-2@example.com", name, rString)
+	domain := fmt.Sprintf("*@tf-%s-%s.example.com", name, rString)
+	resourceName := "clerk_" + name + ".test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccClearIdentifierList(t, name)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIdentifierListResourceConfig(name, first, second),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "identifiers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "identifiers.*", first),
+					resource.TestCheckTypeSetElemAttr(resourceName, "identifiers.*", second),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+			},
+			// Removing and adding identifiers in one apply
+			{
+				Config: testAccIdentifierListResourceConfig(name, second, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "identifiers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "identifiers.*", second),
+					resource.TestCheckTypeSetElemAttr(resourceName, "identifiers.*", domain),
+				),
+			},
+		},
+	})
+}
+
+// testAccClearIdentifierList removes every identifier from the list, so the
+// import step only finds the identifiers created by the test
+func testAccClearIdentifierList(t *testing.T, name string) {
+	kind := allowlist
+	if name == blocklist.name {
+		kind = blocklist
+	}
+
+	ctx := context.Background()
+	client := testAccClerkClient()
+	current, err := kind.list(ctx, client)
+	if err != nil {
+		t.Fatalf("could not list the %s: %s", name, err)
+	}
+	for identifier, id := range current {
+		if err := kind.remove(ctx, client, id); err != nil {
+			t.Fatalf("could not remove %s from the %s: %s", identifier, name, err)
+		}
+	}
+}
+
+func testAccIdentifierListResourceConfig(name string, identifiers ...string) string {
+	quoted := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		quoted[i] = fmt.Sprintf("%q", identifier)
+	}
+	return fmt.Sprintf(`
+resource "clerk_%[1]s" "test" {
+  identifiers = [%[2]s]
+}
+`, name, strings.Join(quoted, ", "))
+}
+
+func TestIdentifierListDelta(t *testing.T) {
+	current := map[string]string{
+		"kept@example.com":    "id_1",
+		"Removed@example.com": "id_2",
+		"*@example.org":       "id_3",
+	}
+	desired := []string{"KEPT@example.com", "*@example.org", "added@example.com"}
+
+	add, remove := identifierListDelta(desired, current)
+	if want := []string{"added@example.com"}; !reflect.DeepEqual(add, want) {
+		t.Errorf("expected to add %v, got %v", want, add)
+	}
+	if want := []string{"Removed@example.com"}; !reflect.DeepEqual(remove, want) {
+		t.Errorf("expected to remove %v, got %v", want, remove)
+	}
+
+	if want := []string{"Removed@example.com"}; !reflect.DeepEqual(filterIdentifiers(remove, []string{"removed@example.com"}), want) {
+		t.Errorf("expected filtered identifiers %v", want)
+	}
+}
+
+func TestUniqueIdentifiers(t *testing.T) {
+	for _, tc := range []struct {
+		identifiers []string
+		wantError   bool
+	}{
+		{[]string{"jane@example.com", "*@example.org"}, false},
+		{nil, false},
+		{[]string{"jane@example.com", "Jane@Example.com"}, true},
+	} {
+		elements := make([]attr.Value, len(tc.identifiers))
+		for i, identifier := range tc.identifiers {
+			elements[i] = types.StringValue(identifier)
+		}
+		req := validator.SetRequest{
+			Path:        path.Root("identifiers"),
+			ConfigValue: types.SetValueMust(types.StringType, elements),
+		}
+		resp := &validator.SetResponse{}
+		uniqueIdentifiers().ValidateSet(context.Background(), req, resp)
+
+		if got := resp.Diagnostics.HasError(); got != tc.wantError {
+			t.Errorf("%v: expected error %t, got %t", tc.identifiers, tc.wantError, got)
+		}
+	}
+}
+
+func TestForEachConcurrently(t *testing.T) {
+	const limit = 3
+
+	var (
+		mu            sync.Mutex
+		running, peak int
+		seen          []string
+		items         = []string{"a", "b", "c", "d", "e", "f", "g"}
+		errFailed     = errors.New("failed")
+	)
+	err := forEachConcurrently(items, limit, func(item string) error {
+		mu.Lock()
+		running++
+		if running > peak {
+			peak = running
+		}
+		seen = append(seen, item)
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+
+		if item == "c" || item == "f" {
+			return fmt.Errorf("%s: %w", item, errFailed)
+		}
+		return nil
+	})
+
+	if len(seen) != len(items) {
+		t.Errorf("expected %d calls, got %d", len(items), len(seen))
+	}
+	if peak > limit {
+		t.Errorf("expected at most %d concurrent calls, got %d", limit, peak)
+	}
+	if !errors.Is(err, errFailed) || !strings.Contains(err.Error(), "c: failed") || !strings.Contains(err.Error(), "f: failed") {
+		t.Errorf("expected both failures to be reported, got %v", err)
+	}
+}
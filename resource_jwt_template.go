@@ -0,0 +1,405 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/jwttemplate"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                   = &jwtTemplateResource{}
+	_ resource.ResourceWithConfigure      = &jwtTemplateResource{}
+	_ resource.ResourceWithImportState    = &jwtTemplateResource{}
+	_ resource.ResourceWithValidateConfig = &jwtTemplateResource{}
+)
+
+// NewJWTTemplateResource is a helper function to simplify the provider implementation
+func NewJWTTemplateResource() resource.Resource {
+	return &jwtTemplateResource{}
+}
+
+// jwtTemplateResource is the resource implementation
+type jwtTemplateResource struct {
+	client *ClerkClient
+}
+
+// jwtTemplateResourceModel describes the resource data model
+type jwtTemplateResourceModel struct {
+	ID                types.String  `tfsdk:"id"`
+	Name              types.String  `tfsdk:"name"`
+	Claims            jsonString    `tfsdk:"claims"`
+	ClaimsObject      types.Dynamic `tfsdk:"claims_object"`
+	Lifetime          types.Int64   `tfsdk:"lifetime"`
+	AllowedClockSkew  types.Int64   `tfsdk:"allowed_clock_skew"`
+	CustomSigningKey  types.Bool    `tfsdk:"custom_signing_key"`
+	SigningAlgorithm  types.String  `tfsdk:"signing_algorithm"`
+	SigningKey        types.String  `tfsdk:"signing_key"`
+	SigningKeyVersion types.Int64   `tfsdk:"signing_key_version"`
+}
+
+// Metadata returns the resource type name
+func (r *jwtTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jwt_template"
+}
+
+// Schema defines the schema for the resource
+func (r *jwtTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Clerk JWT template, used to mint session tokens with custom claims for other services.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the JWT template.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the template, used to request a token from it, e.g. hasura.",
+				Required:    true,
+			},
+			"claims": schema.StringAttribute{
+				Description: "The claims of the token as a JSON object, which may use Clerk shortcodes such as {{user.id}}. " +
+					"Conflicts with claims_object.",
+				Optional:   true,
+				CustomType: jsonStringType{},
+				PlanModifiers: []planmodifier.String{
+					jsonSemanticEquality(),
+				},
+			},
+			"claims_object": schema.DynamicAttribute{
+				Description: "The claims of the token as an object, shown key by key in plans. Conflicts with claims.",
+				Optional:    true,
+			},
+			"lifetime": schema.Int64Attribute{
+				Description: "How long a token is valid, in seconds. Defaults to 60.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"allowed_clock_skew": schema.Int64Attribute{
+				Description: "Leeway for the clock of the service verifying the token, in seconds. Defaults to 5.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_signing_key": schema.BoolAttribute{
+				Description: "Whether tokens are signed with signing_key instead of the instance key. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signing_algorithm": schema.StringAttribute{
+				Description: "The algorithm used to sign tokens with the custom signing key, e.g. HS256 or RS256.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signing_key": schema.StringAttribute{
+				Description: "The custom signing key. This value is never stored in state; " +
+					"change signing_key_version to send a new key for an existing template.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"signing_key_version": schema.Int64Attribute{
+				Description: "Changing this value sends the configured signing_key to Clerk again.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *jwtTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *ClerkClient, got something else. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that the claims are set in exactly one form
+func (r *jwtTemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jwtTemplateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Claims.IsNull() == config.ClaimsObject.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("claims"),
+			"Invalid Claims",
+			"Exactly one of claims or claims_object must be set.",
+		)
+	}
+
+	if !config.ClaimsObject.IsNull() && !config.ClaimsObject.IsUnknown() && !config.ClaimsObject.IsUnderlyingValueUnknown() {
+		switch config.ClaimsObject.UnderlyingValue().(type) {
+		case types.Object, types.Map:
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("claims_object"),
+				"Invalid Claims",
+				"Claims must be an object, e.g. { role = \"authenticated\" }.",
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state
+func (r *jwtTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan jwtTemplateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The signing key is write-only, so it is only available in the configuration
+	var signingKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("signing_key"), &signingKey)...)
+
+	// Create the template parameters
+	params := &jwttemplate.CreateParams{
+		Name:   clerk.String(plan.Name.ValueString()),
+		Claims: plan.expandClaims(&resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Lifetime.IsNull() && !plan.Lifetime.IsUnknown() {
+		params.Lifetime = clerk.Int64(plan.Lifetime.ValueInt64())
+	}
+
+	if !plan.AllowedClockSkew.IsNull() && !plan.AllowedClockSkew.IsUnknown() {
+		params.AllowedClockSkew = clerk.Int64(plan.AllowedClockSkew.ValueInt64())
+	}
+
+	if !plan.CustomSigningKey.IsNull() && !plan.CustomSigningKey.IsUnknown() {
+		params.CustomSigningKey = clerk.Bool(plan.CustomSigningKey.ValueBool())
+	}
+
+	if !plan.SigningAlgorithm.IsNull() && !plan.SigningAlgorithm.IsUnknown() {
+		params.SigningAlgorithm = clerk.String(plan.SigningAlgorithm.ValueString())
+	}
+
+	if !signingKey.IsNull() && !signingKey.IsUnknown() {
+		params.SigningKey = clerk.String(signingKey.ValueString())
+	}
+
+	// Create the template
+	template, err := r.client.CreateJWTTemplate(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating JWT template",
+			"Could not create JWT template "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	plan.ID = types.StringValue(template.ID)
+	resp.Diagnostics.Append(plan.setTemplate(template)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data
+func (r *jwtTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state jwtTemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the template from Clerk
+	template, err := r.client.GetJWTTemplate(ctx, state.ID.ValueString())
+	if isNotFound(err) {
+		// The template was deleted outside Terraform, so let Terraform plan to recreate it
+		tflog.Warn(ctx, "JWT template not found, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading JWT template",
+			"Could not read JWT template ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update state with refreshed values
+	resp.Diagnostics.Append(state.setTemplate(template)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success
+func (r *jwtTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state jwtTemplateResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var signingKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("signing_key"), &signingKey)...)
+
+	// Create the template update parameters
+	params := &jwttemplate.UpdateParams{
+		Name:   clerk.String(plan.Name.ValueString()),
+		Claims: plan.expandClaims(&resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Lifetime.IsNull() && !plan.Lifetime.IsUnknown() {
+		params.Lifetime = clerk.Int64(plan.Lifetime.ValueInt64())
+	}
+
+	if !plan.AllowedClockSkew.IsNull() && !plan.AllowedClockSkew.IsUnknown() {
+		params.AllowedClockSkew = clerk.Int64(plan.AllowedClockSkew.ValueInt64())
+	}
+
+	if !plan.CustomSigningKey.IsNull() && !plan.CustomSigningKey.IsUnknown() {
+		params.CustomSigningKey = clerk.Bool(plan.CustomSigningKey.ValueBool())
+	}
+
+	if !plan.SigningAlgorithm.IsNull() && !plan.SigningAlgorithm.IsUnknown() {
+		params.SigningAlgorithm = clerk.String(plan.SigningAlgorithm.ValueString())
+	}
+
+	// Only send the signing key when its version changes, since write-only
+	// values cannot be compared with the prior state
+	if !plan.SigningKeyVersion.Equal(state.SigningKeyVersion) && !signingKey.IsNull() && !signingKey.IsUnknown() {
+		params.SigningKey = clerk.String(signingKey.ValueString())
+	}
+
+	// Update the template
+	template, err := r.client.UpdateJWTTemplate(ctx, plan.ID.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating JWT template",
+			"Could not update JWT template ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	resp.Diagnostics.Append(plan.setTemplate(template)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success
+func (r *jwtTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jwtTemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the template
+	err := r.client.DeleteJWTTemplate(ctx, state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting JWT template",
+			"Could not delete JWT template ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform state
+func (r *jwtTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// expandClaims returns the claims to send to Clerk from whichever form is set
+func (m *jwtTemplateResourceModel) expandClaims(diags *diag.Diagnostics) json.RawMessage {
+	claims := expandMetadata(m.Claims, "claims", diags)
+	if !m.ClaimsObject.IsNull() {
+		claims = expandMetadataObject(m.ClaimsObject, "claims_object", diags)
+	}
+	if claims == nil {
+		return nil
+	}
+	return *claims
+}
+
+// setTemplate copies the attributes returned by the Clerk API into the
+// model, writing the claims to whichever form is in use
+func (m *jwtTemplateResourceModel) setTemplate(template *clerk.JWTTemplate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Name = types.StringValue(template.Name)
+	m.Lifetime = types.Int64Value(template.Lifetime)
+	m.AllowedClockSkew = types.Int64Value(template.AllowedClockSkew)
+	m.CustomSigningKey = types.BoolValue(template.CustomSigningKey)
+	m.SigningAlgorithm = types.StringValue(template.SigningAlgorithm)
+
+	if m.ClaimsObject.IsNull() {
+		m.Claims = flattenMetadata(template.Claims)
+	} else {
+		m.ClaimsObject = flattenMetadataObject(template.Claims, m.ClaimsObject, "claims_object", &diags)
+	}
+
+	return diags
+}
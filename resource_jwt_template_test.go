@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccJWTTemplateResource(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	name := "tf-test-" + rString
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccJWTTemplateResourceConfig(name, `{"aud":"tf-test","user":"{{user.id}}"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_jwt_template.test", "name", name),
+					resource.TestCheckResourceAttr("clerk_jwt_template.test", "lifetime", "60"),
+					resource.TestCheckResourceAttr("clerk_jwt_template.test", "allowed_clock_skew", "5"),
+					resource.TestCheckResourceAttr("clerk_jwt_template.test", "custom_signing_key", "false"),
+					resource.TestCheckResourceAttrSet("clerk_jwt_template.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "clerk_jwt_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update claims and lifetime
+			{
+				Config: testAccJWTTemplateResourceConfigUpdated(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_jwt_template.test", "claims_object.aud", "tf-test"),
+					resource.TestCheckResourceAttr("clerk_jwt_template.test", "claims_object.role", "authenticated"),
+					resource.TestCheckResourceAttr("clerk_jwt_template.test", "lifetime", "300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJWTTemplateResource_customSigningKey(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	name := "tf-test-" + rString
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Write-only attributes need Terraform 1.11 or later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJWTTemplateResourceConfigSigningKey(name, rString, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_jwt_template.test", "custom_signing_key", "true"),
+					resource.TestCheckResourceAttr("clerk_jwt_template.test", "signing_algorithm", "HS256"),
+					resource.TestCheckNoResourceAttr("clerk_jwt_template.test", "signing_key"),
+				),
+			},
+			// Rotating the key
+			{
+				Config: testAccJWTTemplateResourceConfigSigningKey(name, rString+"-rotated", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_jwt_template.test", "signing_key_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJWTTemplateResource_invalidClaims(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "clerk_jwt_template" "test" {
+  name          = "tf-test-invalid"
+  claims        = jsonencode({ aud = "tf-test" })
+  claims_object = { aud = "tf-test" }
+}
+`,
+				ExpectError: regexp.MustCompile(`Exactly one of claims or claims_object`),
+			},
+		},
+	})
+}
+
+func testAccJWTTemplateResourceConfig(name, claims string) string {
+	return fmt.Sprintf(`
+resource "clerk_jwt_template" "test" {
+  name   = %[1]q
+  claims = %[2]q
+}
+`, name, claims)
+}
+
+func testAccJWTTemplateResourceConfigUpdated(name string) string {
+	return fmt.Sprintf(`
+resource "clerk_jwt_template" "test" {
+  name     = %[1]q
+  lifetime = 300
+
+  claims_object = {
+    aud  = "tf-test"
+    role = "authenticated"
+  }
+}
+`, name)
+}
+
+func testAccJWTTemplateResourceConfigSigningKey(name, key string, version int) string {
+	return fmt.Sprintf(`
+resource "clerk_jwt_template" "test" {
+  name                = %[1]q
+  claims              = jsonencode({ aud = "tf-test" })
+  custom_signing_key  = true
+  signing_algorithm   = "HS256"
+  signing_key         = "tf-test-secret-%[2]s-0123456789abcdef"
+  signing_key_version = %[3]d
+}
+`, name, key, version)
+}
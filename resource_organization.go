@@ -1,50 +1,45 @@
 package main
 
 import (
-	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"io"
+	"os"
+	"strings"
 
 	"github.com/clerk/clerk-sdk-go/v2"
 	"github.com/clerk/clerk-sdk-go/v2/organization"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-// normalizeJSON normalizes a JSON string to ensure consistent formatting
-func normalizeJSON(jsonStr string) (string, error) {
-	if jsonStr == "" {
-		return "", nil
-	}
-	var data interface{}
-	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
-		return "", err
-	}
-	var buf bytes.Buffer
-	encoder := json.NewEncoder(&buf)
-	encoder.SetIndent("", "")
-	encoder.SetEscapeHTML(false)
-	if err := encoder.Encode(data); err != nil {
-		return "", err
-	}
-	// Remove trailing newline added by Encode
-	result := buf.String()
-	if len(result) > 0 && result[len(result)-1] == '\n' {
-		result = result[:len(result)-1]
-	}
-	return result, nil
-}
+// How the metadata of an organization is managed
+const (
+	// metadataManagementAuthoritative replaces all metadata with the configuration
+	metadataManagementAuthoritative = "authoritative"
+	// metadataManagementMerge only manages the keys set in the configuration
+	metadataManagementMerge = "merge"
+)
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ resource.Resource                = &organizationResource{}
-	_ resource.ResourceWithConfigure   = &organizationResource{}
-	_ resource.ResourceWithImportState = &organizationResource{}
+	_ resource.Resource                   = &organizationResource{}
+	_ resource.ResourceWithConfigure      = &organizationResource{}
+	_ resource.ResourceWithImportState    = &organizationResource{}
+	_ resource.ResourceWithValidateConfig = &organizationResource{}
+	_ resource.ResourceWithModifyPlan     = &organizationResource{}
 )
 
 // NewOrganizationResource is a helper function to simplify the provider implementation
@@ -59,13 +54,27 @@ type organizationResource struct {
 
 // organizationResourceModel describes the resource data model
 type organizationResourceModel struct {
-	ID                    types.String `tfsdk:"id"`
-	Name                  types.String `tfsdk:"name"`
-	Slug                  types.String `tfsdk:"slug"`
-	MaxAllowedMemberships types.Int64  `tfsdk:"max_allowed_memberships"`
-	PublicMetadata        types.String `tfsdk:"public_metadata"`
-	PrivateMetadata       types.String `tfsdk:"private_metadata"`
-	CreatedBy             types.String `tfsdk:"created_by"`
+	ID                    types.String  `tfsdk:"id"`
+	Name                  types.String  `tfsdk:"name"`
+	Slug                  types.String  `tfsdk:"slug"`
+	MaxAllowedMemberships types.Int64   `tfsdk:"max_allowed_memberships"`
+	PublicMetadata        jsonString    `tfsdk:"public_metadata"`
+	PrivateMetadata       jsonString    `tfsdk:"private_metadata"`
+	PublicMetadataObject  types.Dynamic `tfsdk:"public_metadata_object"`
+	PrivateMetadataObject types.Dynamic `tfsdk:"private_metadata_object"`
+	MetadataManagement    types.String  `tfsdk:"metadata_management"`
+	CreatedBy             types.String  `tfsdk:"created_by"`
+	DeletionProtection    types.Bool    `tfsdk:"deletion_protection"`
+	LogoPath              types.String  `tfsdk:"logo_path"`
+	LogoSHA256            types.String  `tfsdk:"logo_sha256"`
+
+	CreatedAt               types.String `tfsdk:"created_at"`
+	UpdatedAt               types.String `tfsdk:"updated_at"`
+	MembersCount            types.Int64  `tfsdk:"members_count"`
+	PendingInvitationsCount types.Int64  `tfsdk:"pending_invitations_count"`
+	HasImage                types.Bool   `tfsdk:"has_image"`
+	ImageURL                types.String `tfsdk:"image_url"`
+	AdminDeleteEnabled      types.Bool   `tfsdk:"admin_delete_enabled"`
 }
 
 // Metadata returns the resource type name
@@ -90,12 +99,16 @@ func (r *organizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Required:    true,
 			},
 			"slug": schema.StringAttribute{
-				Description: "The slug of the organization. If not provided, one will be generated from the name.",
-				Optional:    true,
-				Computed:    true,
+				Description: "The slug of the organization, made of lowercase letters, digits and dashes. " +
+					"If not provided, one will be generated from the name. Must not be used by another organization.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					validSlug(),
+				},
 			},
 			"max_allowed_memberships": schema.Int64Attribute{
 				Description: "The maximum number of memberships allowed for the organization.",
@@ -106,22 +119,46 @@ func (r *organizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"public_metadata": schema.StringAttribute{
-				Description: "Public metadata for the organization (JSON string).",
-				Optional:    true,
-				Computed:    true,
+				Description: "Public metadata for the organization (JSON string). Removing it from the configuration " +
+					"clears the metadata once; metadata Terraform never set is left untouched. Conflicts with public_metadata_object.",
+				Optional:   true,
+				Computed:   true,
+				CustomType: jsonStringType{},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					jsonSemanticEquality(),
+					nullWhenUnconfigured(),
 				},
 			},
 			"private_metadata": schema.StringAttribute{
-				Description: "Private metadata for the organization (JSON string).",
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
+				Description: "Private metadata for the organization (JSON string). Removing it from the configuration " +
+					"clears the metadata once; metadata Terraform never set is left untouched. Conflicts with private_metadata_object.",
+				Optional:   true,
+				Computed:   true,
+				Sensitive:  true,
+				CustomType: jsonStringType{},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					jsonSemanticEquality(),
+					nullWhenUnconfigured(),
 				},
 			},
+			"public_metadata_object": schema.DynamicAttribute{
+				Description: "Public metadata for the organization as an object, shown key by key in plans. " +
+					"Conflicts with public_metadata.",
+				Optional: true,
+			},
+			"private_metadata_object": schema.DynamicAttribute{
+				Description: "Private metadata for the organization as an object. Conflicts with private_metadata.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"metadata_management": schema.StringAttribute{
+				Description: "How metadata is managed: authoritative replaces all metadata with the configuration, " +
+					"merge only adds, changes and removes the keys set in the configuration and leaves keys written " +
+					"by other clients untouched. Defaults to authoritative.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(metadataManagementAuthoritative),
+			},
 			"created_by": schema.StringAttribute{
 				Description: "The user ID who created the organization.",
 				Optional:    true,
@@ -129,6 +166,66 @@ func (r *organizationResource) Schema(_ context.Context, _ resource.SchemaReques
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "Whether Terraform refuses to destroy or replace the organization. " +
+					"Set to false and apply before removing a protected organization. " +
+					"Defaults to the provider's deletion_protection setting.",
+				Optional: true,
+				Computed: true,
+			},
+			"logo_path": schema.StringAttribute{
+				Description: "Path to an image file uploaded as the organization's logo. Removing it deletes the logo. " +
+					"When not set, the logo is not managed by Terraform.",
+				Optional: true,
+			},
+			"logo_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the logo file, hex encoded. A new logo is uploaded whenever it changes. " +
+					"Computed from the file at logo_path when not set, e.g. set it to filesha256(...) to avoid reading the file while planning.",
+				Optional: true,
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the organization was created, as an RFC 3339 timestamp.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "When the organization was last updated, as an RFC 3339 timestamp.",
+				Computed:    true,
+			},
+			"members_count": schema.Int64Attribute{
+				Description: "The number of members of the organization.",
+				Computed:    true,
+			},
+			"pending_invitations_count": schema.Int64Attribute{
+				Description: "The number of pending invitations to the organization.",
+				Computed:    true,
+			},
+			"has_image": schema.BoolAttribute{
+				Description: "Whether the organization has a logo.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image_url": schema.StringAttribute{
+				Description: "The URL of the organization's logo, or of a generated image if it has none.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"admin_delete_enabled": schema.BoolAttribute{
+				Description: "Whether organization admins can delete the organization. Set to false so that only " +
+					"Terraform or the Clerk dashboard can delete it. Defaults to the Clerk setting, which allows it.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -151,6 +248,188 @@ func (r *organizationResource) Configure(_ context.Context, req resource.Configu
 	r.client = client
 }
 
+// ValidateConfig ensures each kind of metadata is only set in one form
+func (r *organizationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config organizationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.PublicMetadata.IsNull() && !config.PublicMetadataObject.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("public_metadata_object"),
+			"Conflicting Metadata",
+			"Only one of public_metadata or public_metadata_object can be set.",
+		)
+	}
+	if !config.PrivateMetadata.IsNull() && !config.PrivateMetadataObject.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_metadata_object"),
+			"Conflicting Metadata",
+			"Only one of private_metadata or private_metadata_object can be set.",
+		)
+	}
+
+	if config.LogoPath.IsNull() && !config.LogoSHA256.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("logo_sha256"),
+			"Missing Logo Path",
+			"logo_sha256 can only be set together with logo_path.",
+		)
+	}
+
+	validateMetadataObject(config.PublicMetadataObject, path.Root("public_metadata_object"), &resp.Diagnostics)
+	validateMetadataObject(config.PrivateMetadataObject, path.Root("private_metadata_object"), &resp.Diagnostics)
+
+	if !config.MetadataManagement.IsNull() && !config.MetadataManagement.IsUnknown() {
+		switch config.MetadataManagement.ValueString() {
+		case metadataManagementAuthoritative, metadataManagementMerge:
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("metadata_management"),
+				"Invalid Metadata Management",
+				"metadata_management must be authoritative or merge, got: "+config.MetadataManagement.ValueString(),
+			)
+		}
+	}
+}
+
+// ModifyPlan applies the provider-level default for deletion_protection,
+// which a static schema default cannot express, plans logo uploads and checks
+// that a new slug is not already taken by another organization
+func (r *organizationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the organization is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.planLogo(ctx, req, resp)
+
+	// The remaining checks need the provider to be configured
+	if resp.Diagnostics.HasError() || r.client == nil {
+		return
+	}
+
+	var deletionProtection types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if deletionProtection.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deletion_protection"), r.client.DeletionProtection)...)
+	}
+
+	r.checkSlugAvailable(ctx, req, resp)
+}
+
+// planLogo computes logo_sha256 from the file at logo_path, so that editing
+// the file plans a new upload, and marks the image attributes as changing
+// whenever the logo is uploaded or deleted. The generated image of an
+// organization without a logo depends on its name, so image_url is also
+// marked as changing on a rename.
+func (r *organizationResource) planLogo(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var logoPath, logoSHA256 types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("logo_path"), &logoPath)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("logo_sha256"), &logoSHA256)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if logoSHA256.IsNull() {
+		switch {
+		case logoPath.IsNull():
+			logoSHA256 = types.StringNull()
+		case logoPath.IsUnknown():
+			logoSHA256 = types.StringUnknown()
+		default:
+			sum, err := fileSHA256(logoPath.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("logo_path"),
+					"Invalid Logo Path",
+					"Could not read the logo file: "+err.Error(),
+				)
+				return
+			}
+			logoSHA256 = types.StringValue(sum)
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("logo_sha256"), logoSHA256)...)
+	}
+
+	// A new organization has no image attributes to keep yet
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var name, priorName, priorLogoPath, priorLogoSHA256 types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &priorName)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("logo_path"), &priorLogoPath)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("logo_sha256"), &priorLogoSHA256)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uploading := !logoPath.IsNull() && !logoSHA256.Equal(priorLogoSHA256)
+	deleting := logoPath.IsNull() && !priorLogoPath.IsNull()
+	if uploading || deleting {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("has_image"), types.BoolUnknown())...)
+	}
+	if uploading || deleting || !name.Equal(priorName) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("image_url"), types.StringUnknown())...)
+	}
+}
+
+// checkSlugAvailable reports a configured slug that already belongs to a
+// different organization. Clerk would otherwise only reject it during apply.
+func (r *organizationResource) checkSlugAvailable(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var slug types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("slug"), &slug)...)
+	if resp.Diagnostics.HasError() || slug.IsNull() || slug.IsUnknown() || !slugPattern.MatchString(slug.ValueString()) {
+		return
+	}
+
+	var id, priorSlug types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("slug"), &priorSlug)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The slug is not changing, so it can only belong to this organization
+	if priorSlug.ValueString() == slug.ValueString() {
+		return
+	}
+
+	// The Clerk API accepts the slug in place of the ID
+	org, err := r.client.GetOrganization(ctx, slug.ValueString())
+	if err != nil {
+		// Leave any other failure for the apply to report, e.g. when the
+		// API cannot be reached while planning
+		if !isNotFound(err) {
+			tflog.Warn(ctx, "Could not check whether the organization slug is available", map[string]interface{}{
+				"slug":  slug.ValueString(),
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+
+	if org.ID != id.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("slug"),
+			"Slug Already In Use",
+			"The slug "+slug.ValueString()+" is already used by organization "+org.ID+". "+
+				"Choose a different slug, or import the existing organization with: terraform import <address> slug:"+slug.ValueString(),
+		)
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state
 func (r *organizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan organizationResourceModel
@@ -163,7 +442,12 @@ func (r *organizationResource) Create(ctx context.Context, req resource.CreateRe
 
 	// Create the organization parameters
 	params := &organization.CreateParams{
-		Name: clerk.String(plan.Name.ValueString()),
+		Name:            clerk.String(plan.Name.ValueString()),
+		PublicMetadata:  plan.expandPublicMetadata(&resp.Diagnostics),
+		PrivateMetadata: plan.expandPrivateMetadata(&resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if !plan.Slug.IsNull() && !plan.Slug.IsUnknown() {
@@ -178,32 +462,6 @@ func (r *organizationResource) Create(ctx context.Context, req resource.CreateRe
 		params.CreatedBy = clerk.String(plan.CreatedBy.ValueString())
 	}
 
-	// Parse public metadata
-	if !plan.PublicMetadata.IsNull() && !plan.PublicMetadata.IsUnknown() {
-		var metadata json.RawMessage
-		if err := json.Unmarshal([]byte(plan.PublicMetadata.ValueString()), &metadata); err != nil {
-			resp.Diagnostics.AddError(
-				"Error parsing public_metadata",
-				"Could not parse public_metadata as JSON: "+err.Error(),
-			)
-			return
-		}
-		params.PublicMetadata = &metadata
-	}
-
-	// Parse private metadata
-	if !plan.PrivateMetadata.IsNull() && !plan.PrivateMetadata.IsUnknown() {
-		var metadata json.RawMessage
-		if err := json.Unmarshal([]byte(plan.PrivateMetadata.ValueString()), &metadata); err != nil {
-			resp.Diagnostics.AddError(
-				"Error parsing private_metadata",
-				"Could not parse private_metadata as JSON: "+err.Error(),
-			)
-			return
-		}
-		params.PrivateMetadata = &metadata
-	}
-
 	// Create the organization
 	org, err := r.client.CreateOrganization(ctx, params)
 	if err != nil {
@@ -217,63 +475,64 @@ func (r *organizationResource) Create(ctx context.Context, req resource.CreateRe
 	// Set the ID so we can fetch the full resource
 	plan.ID = types.StringValue(org.ID)
 
+	// Clerk does not accept admin_delete_enabled on create, so set it on the
+	// new organization right away
+	if !plan.AdminDeleteEnabled.IsNull() && !plan.AdminDeleteEnabled.IsUnknown() {
+		_, err = r.client.UpdateOrganization(ctx, org.ID, &organization.UpdateParams{
+			AdminDeleteEnabled: clerk.Bool(plan.AdminDeleteEnabled.ValueBool()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating organization",
+				"Could not set admin_delete_enabled on organization ID "+org.ID+": "+err.Error(),
+			)
+			// Keep the organization in state so it is tainted rather than leaked
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), org.ID)...)
+			return
+		}
+	}
+
+	if !plan.LogoPath.IsNull() {
+		if err := r.uploadLogo(ctx, org.ID, &plan); err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating organization",
+				"Could not upload the logo of organization ID "+org.ID+": "+err.Error(),
+			)
+			// Keep the organization in state so it is tainted rather than leaked
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), org.ID)...)
+			return
+		}
+	}
+
 	// Fetch the organization again to get the complete state from the API
 	// This ensures we capture any computed fields or defaults set by the API
-	org, err = r.client.GetOrganization(ctx, org.ID)
+	org, err = r.getOrganization(ctx, org.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading organization after create",
-			"Could not read organization ID "+org.ID+" after creation: "+err.Error(),
+			"Could not read organization ID "+plan.ID.ValueString()+" after creation: "+err.Error(),
 		)
 		return
 	}
 
 	// Map response to state
-	plan.Name = types.StringValue(org.Name)
-	plan.Slug = types.StringValue(org.Slug)
-
-	// Always set a known value for max_allowed_memberships
-	if org.MaxAllowedMemberships > 0 {
-		plan.MaxAllowedMemberships = types.Int64Value(org.MaxAllowedMemberships)
-	} else {
-		plan.MaxAllowedMemberships = types.Int64Null()
+	resp.Diagnostics.Append(plan.setOrganization(org)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// For metadata, preserve the original plan values if they were set
-	// This avoids issues with JSON key ordering differences
-	if !plan.PublicMetadata.IsNull() && !plan.PublicMetadata.IsUnknown() {
-		// Keep the original value from plan - it contains the same data
-		// just potentially in a different key order
-	} else if org.PublicMetadata != nil {
-		metadata, err := json.Marshal(org.PublicMetadata)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error serializing public_metadata",
-				"Could not serialize public_metadata: "+err.Error(),
-			)
-			return
-		}
-		plan.PublicMetadata = types.StringValue(string(metadata))
-	} else {
-		plan.PublicMetadata = types.StringNull()
+	if plan.DeletionProtection.IsUnknown() {
+		plan.DeletionProtection = types.BoolValue(r.client.DeletionProtection)
 	}
 
-	if !plan.PrivateMetadata.IsNull() && !plan.PrivateMetadata.IsUnknown() {
-		// Keep the original value from plan
-	} else if org.PrivateMetadata != nil {
-		metadata, err := json.Marshal(org.PrivateMetadata)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error serializing private_metadata",
-				"Could not serialize private_metadata: "+err.Error(),
-			)
-			return
-		}
-		plan.PrivateMetadata = types.StringValue(string(metadata))
-	} else {
-		plan.PrivateMetadata = types.StringNull()
+	// Only left unknown when logo_path turned out to be null
+	if plan.LogoSHA256.IsUnknown() {
+		plan.LogoSHA256 = types.StringNull()
 	}
 
+	// Record the metadata Terraform now manages
+	resp.Diagnostics.Append(setManagedMetadata(ctx, resp.Private, plan.managedMetadata())...)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -289,7 +548,15 @@ func (r *organizationResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get the organization from Clerk
-	org, err := r.client.GetOrganization(ctx, state.ID.ValueString())
+	org, err := r.getOrganization(ctx, state.ID.ValueString())
+	if isNotFound(err) {
+		// The organization was deleted outside Terraform, so let Terraform plan to recreate it
+		tflog.Warn(ctx, "Organization not found, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading organization",
@@ -298,83 +565,51 @@ func (r *organizationResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	// Update state with refreshed values
-	state.Name = types.StringValue(org.Name)
-	state.Slug = types.StringValue(org.Slug)
-
-	// Always set a known value for max_allowed_memberships
-	if org.MaxAllowedMemberships > 0 {
-		state.MaxAllowedMemberships = types.Int64Value(org.MaxAllowedMemberships)
-	} else {
-		state.MaxAllowedMemberships = types.Int64Null()
+	// Only refresh the metadata Terraform manages
+	managed, diags := refreshManagedMetadata(ctx, resp.Private, map[string]json.RawMessage{
+		"public_metadata":  org.PublicMetadata,
+		"private_metadata": org.PrivateMetadata,
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !managed["public_metadata"] {
+		state.PublicMetadataObject = types.DynamicNull()
+	}
+	if state.PublicMetadataObject.IsNull() {
+		state.PublicMetadata = refreshMetadata(state.PublicMetadata, org.PublicMetadata, managed["public_metadata"])
+	}
+	if !managed["private_metadata"] {
+		state.PrivateMetadataObject = types.DynamicNull()
+	}
+	if state.PrivateMetadataObject.IsNull() {
+		state.PrivateMetadata = refreshMetadata(state.PrivateMetadata, org.PrivateMetadata, managed["private_metadata"])
 	}
 
-	// Handle public_metadata with normalization
-	if org.PublicMetadata != nil {
-		metadata, err := json.Marshal(org.PublicMetadata)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error serializing public_metadata",
-				"Could not serialize public_metadata: "+err.Error(),
-			)
-			return
-		}
-		newValue, err := normalizeJSON(string(metadata))
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error normalizing public_metadata",
-				"Could not normalize public_metadata: "+err.Error(),
-			)
-			return
-		}
+	// Update state with refreshed values
+	resp.Diagnostics.Append(state.setOrganization(org)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		// If state has a value, normalize it and compare
-		if !state.PublicMetadata.IsNull() {
-			existingNormalized, err := normalizeJSON(state.PublicMetadata.ValueString())
-			if err == nil && existingNormalized == newValue {
-				// Keep existing formatting
-			} else {
-				state.PublicMetadata = types.StringValue(newValue)
-			}
-		} else {
-			state.PublicMetadata = types.StringValue(newValue)
-		}
-	} else {
-		state.PublicMetadata = types.StringNull()
+	// The logo was removed outside Terraform, so forget the uploaded
+	// checksum to plan uploading it again. This is only done here, as
+	// Clerk may not report a logo uploaded moments ago during an apply.
+	if !org.HasImage && !state.LogoPath.IsNull() {
+		state.LogoSHA256 = types.StringNull()
 	}
 
-	// Handle private_metadata with normalization
-	if org.PrivateMetadata != nil {
-		metadata, err := json.Marshal(org.PrivateMetadata)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error serializing private_metadata",
-				"Could not serialize private_metadata: "+err.Error(),
-			)
-			return
-		}
-		newValue, err := normalizeJSON(string(metadata))
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error normalizing private_metadata",
-				"Could not normalize private_metadata: "+err.Error(),
-			)
-			return
-		}
+	// Deletion protection only exists in Terraform, so an imported
+	// organization starts with the provider default
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(r.client.DeletionProtection)
+	}
 
-		// If state has a value, normalize it and compare
-		if !state.PrivateMetadata.IsNull() {
-			existingNormalized, err := normalizeJSON(state.PrivateMetadata.ValueString())
-			if err == nil && existingNormalized == newValue {
-				// Keep existing formatting
-			} else {
-				state.PrivateMetadata = types.StringValue(newValue)
-			}
-		} else {
-			state.PrivateMetadata = types.StringValue(newValue)
-		}
-	} else {
-		state.PrivateMetadata = types.StringNull()
+	// The same goes for metadata management, e.g. after an import or for
+	// state written before the attribute existed
+	if state.MetadataManagement.IsNull() {
+		state.MetadataManagement = types.StringValue(metadataManagementAuthoritative)
 	}
 
 	// Save updated data into Terraform state
@@ -383,10 +618,11 @@ func (r *organizationResource) Read(ctx context.Context, req resource.ReadReques
 
 // Update updates the resource and sets the updated Terraform state on success
 func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan organizationResourceModel
+	var plan, state organizationResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -396,6 +632,22 @@ func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRe
 		Name: clerk.String(plan.Name.ValueString()),
 	}
 
+	// Metadata removed from the configuration is cleared when Terraform
+	// managed it, and left alone otherwise
+	managed, diags := getManagedMetadata(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	// In merge mode metadata is sent to the merge endpoint below instead,
+	// since the update endpoint replaces it entirely
+	merge := plan.MetadataManagement.ValueString() == metadataManagementMerge
+	if !merge {
+		params.PublicMetadata = clearMetadata(plan.expandPublicMetadata(&resp.Diagnostics), managed["public_metadata"])
+		params.PrivateMetadata = clearMetadata(plan.expandPrivateMetadata(&resp.Diagnostics), managed["private_metadata"])
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !plan.Slug.IsNull() && !plan.Slug.IsUnknown() {
 		params.Slug = clerk.String(plan.Slug.ValueString())
 	}
@@ -404,30 +656,8 @@ func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRe
 		params.MaxAllowedMemberships = clerk.Int64(plan.MaxAllowedMemberships.ValueInt64())
 	}
 
-	// Parse public metadata
-	if !plan.PublicMetadata.IsNull() && !plan.PublicMetadata.IsUnknown() {
-		var metadata json.RawMessage
-		if err := json.Unmarshal([]byte(plan.PublicMetadata.ValueString()), &metadata); err != nil {
-			resp.Diagnostics.AddError(
-				"Error parsing public_metadata",
-				"Could not parse public_metadata as JSON: "+err.Error(),
-			)
-			return
-		}
-		params.PublicMetadata = &metadata
-	}
-
-	// Parse private metadata
-	if !plan.PrivateMetadata.IsNull() && !plan.PrivateMetadata.IsUnknown() {
-		var metadata json.RawMessage
-		if err := json.Unmarshal([]byte(plan.PrivateMetadata.ValueString()), &metadata); err != nil {
-			resp.Diagnostics.AddError(
-				"Error parsing private_metadata",
-				"Could not parse private_metadata as JSON: "+err.Error(),
-			)
-			return
-		}
-		params.PrivateMetadata = &metadata
+	if !plan.AdminDeleteEnabled.IsNull() && !plan.AdminDeleteEnabled.IsUnknown() {
+		params.AdminDeleteEnabled = clerk.Bool(plan.AdminDeleteEnabled.ValueBool())
 	}
 
 	// Update the organization
@@ -440,9 +670,46 @@ func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	if merge {
+		metadataParams := plan.metadataPatch(&state, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if metadataParams.PublicMetadata != nil || metadataParams.PrivateMetadata != nil {
+			_, err := r.client.UpdateOrganizationMetadata(ctx, plan.ID.ValueString(), metadataParams)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error updating organization metadata",
+					"Could not merge metadata into organization ID "+plan.ID.ValueString()+": "+err.Error(),
+				)
+				return
+			}
+		}
+	}
+
+	switch {
+	case !plan.LogoPath.IsNull() && !plan.LogoSHA256.Equal(state.LogoSHA256):
+		if err := r.uploadLogo(ctx, plan.ID.ValueString(), &plan); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating organization logo",
+				"Could not upload the logo of organization ID "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	case plan.LogoPath.IsNull() && !state.LogoPath.IsNull():
+		if _, err := r.client.DeleteOrganizationLogo(ctx, plan.ID.ValueString()); err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting organization logo",
+				"Could not delete the logo of organization ID "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Fetch the organization again to get the latest state from the API
 	// This ensures we capture any values set by the API (like computed fields)
-	org, err := r.client.GetOrganization(ctx, plan.ID.ValueString())
+	org, err := r.getOrganization(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading organization after update",
@@ -452,50 +719,23 @@ func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Map response to state
-	plan.Name = types.StringValue(org.Name)
-	plan.Slug = types.StringValue(org.Slug)
-
-	// Always set a known value for max_allowed_memberships
-	if org.MaxAllowedMemberships > 0 {
-		plan.MaxAllowedMemberships = types.Int64Value(org.MaxAllowedMemberships)
-	} else {
-		plan.MaxAllowedMemberships = types.Int64Null()
+	resp.Diagnostics.Append(plan.setOrganization(org)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// For metadata, preserve the original plan values if they were set
-	// This avoids issues with JSON key ordering differences
-	if !plan.PublicMetadata.IsNull() && !plan.PublicMetadata.IsUnknown() {
-		// Keep the original value from plan
-	} else if org.PublicMetadata != nil {
-		metadata, err := json.Marshal(org.PublicMetadata)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error serializing public_metadata",
-				"Could not serialize public_metadata: "+err.Error(),
-			)
-			return
-		}
-		plan.PublicMetadata = types.StringValue(string(metadata))
-	} else {
-		plan.PublicMetadata = types.StringNull()
+	if plan.DeletionProtection.IsUnknown() {
+		plan.DeletionProtection = types.BoolValue(r.client.DeletionProtection)
 	}
 
-	if !plan.PrivateMetadata.IsNull() && !plan.PrivateMetadata.IsUnknown() {
-		// Keep the original value from plan
-	} else if org.PrivateMetadata != nil {
-		metadata, err := json.Marshal(org.PrivateMetadata)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error serializing private_metadata",
-				"Could not serialize private_metadata: "+err.Error(),
-			)
-			return
-		}
-		plan.PrivateMetadata = types.StringValue(string(metadata))
-	} else {
-		plan.PrivateMetadata = types.StringNull()
+	// Only left unknown when logo_path turned out to be null
+	if plan.LogoSHA256.IsUnknown() {
+		plan.LogoSHA256 = types.StringNull()
 	}
 
+	// Record the metadata Terraform now manages
+	resp.Diagnostics.Append(setManagedMetadata(ctx, resp.Private, plan.managedMetadata())...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -510,9 +750,19 @@ func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	// Delete the organization
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Organization Is Protected From Deletion",
+			"Organization "+state.ID.ValueString()+" ("+state.Slug.ValueString()+") has deletion_protection enabled, "+
+				"so it was not deleted. To destroy or replace it, first set deletion_protection = false and apply.",
+		)
+		return
+	}
+
+	// Delete the organization. An organization that is already gone
+	// counts as deleted.
 	err := r.client.DeleteOrganization(ctx, state.ID.ValueString())
-	if err != nil {
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error deleting organization",
 			"Could not delete organization ID "+state.ID.ValueString()+": "+err.Error(),
@@ -523,6 +773,183 @@ func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRe
 
 // ImportState imports an existing resource into Terraform state
 func (r *organizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Use the ID field for import
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Organizations can be imported by ID, or by slug in the form slug:acme
+	slug, ok := strings.CutPrefix(req.ID, "slug:")
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		resp.Diagnostics.Append(markImported(ctx, resp.Private)...)
+		return
+	}
+
+	if slug == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: organization_id or slug:organization_slug. Got: "+req.ID,
+		)
+		return
+	}
+
+	// The Clerk API accepts the slug in place of the ID
+	org, err := r.client.GetOrganization(ctx, slug)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing organization",
+			"Could not find organization with slug "+slug+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), org.ID)...)
+	resp.Diagnostics.Append(markImported(ctx, resp.Private)...)
+}
+
+// uploadLogo uploads the file at logo_path as the logo of the organization,
+// filling in logo_sha256 if it could not be computed while planning
+func (r *organizationResource) uploadLogo(ctx context.Context, id string, plan *organizationResourceModel) error {
+	if plan.LogoSHA256.IsUnknown() {
+		sum, err := fileSHA256(plan.LogoPath.ValueString())
+		if err != nil {
+			return err
+		}
+		plan.LogoSHA256 = types.StringValue(sum)
+	}
+
+	// The SDK closes the file once it has been read
+	file, err := os.Open(plan.LogoPath.ValueString())
+	if err != nil {
+		return err
+	}
+	_, err = r.client.UpdateOrganizationLogo(ctx, id, &organization.UpdateLogoParams{File: file})
+	return err
+}
+
+// fileSHA256 returns the hex encoded SHA-256 checksum of a file
+func fileSHA256(name string) (string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// getOrganization retrieves an organization together with its member and
+// pending invitation counts, which Clerk only returns when asked for
+func (r *organizationResource) getOrganization(ctx context.Context, id string) (*clerk.Organization, error) {
+	return r.client.GetOrganizationWithParams(ctx, id, &organization.GetParams{
+		IncludeMembersCount: clerk.Bool(true),
+	})
+}
+
+// expandPublicMetadata returns the public metadata to send to Clerk from
+// whichever form is configured
+func (m *organizationResourceModel) expandPublicMetadata(diags *diag.Diagnostics) *json.RawMessage {
+	if !m.PublicMetadataObject.IsNull() {
+		return expandMetadataObject(m.PublicMetadataObject, "public_metadata_object", diags)
+	}
+	return expandMetadata(m.PublicMetadata, "public_metadata", diags)
+}
+
+// expandPrivateMetadata returns the private metadata to send to Clerk from
+// whichever form is configured
+func (m *organizationResourceModel) expandPrivateMetadata(diags *diag.Diagnostics) *json.RawMessage {
+	if !m.PrivateMetadataObject.IsNull() {
+		return expandMetadataObject(m.PrivateMetadataObject, "private_metadata_object", diags)
+	}
+	return expandMetadata(m.PrivateMetadata, "private_metadata", diags)
+}
+
+// managedMetadata returns the metadata attributes set in the model, in
+// either form
+func (m *organizationResourceModel) managedMetadata() map[string]bool {
+	return map[string]bool{
+		"public_metadata":  !m.PublicMetadata.IsNull() || !m.PublicMetadataObject.IsNull(),
+		"private_metadata": !m.PrivateMetadata.IsNull() || !m.PrivateMetadataObject.IsNull(),
+	}
+}
+
+// metadataPatch returns the parameters for Clerk's merge endpoint that turn
+// the metadata keys owned in the prior state into the planned ones. Keys
+// are only removed when the prior state was already in merge mode, so
+// switching modes never deletes keys written by other clients.
+func (m *organizationResourceModel) metadataPatch(state *organizationResourceModel, diags *diag.Diagnostics) *organization.UpdateMetadataParams {
+	var priorPublic, priorPrivate *json.RawMessage
+	if state.MetadataManagement.ValueString() == metadataManagementMerge {
+		priorPublic = state.expandPublicMetadata(diags)
+		priorPrivate = state.expandPrivateMetadata(diags)
+	}
+
+	params := &organization.UpdateMetadataParams{}
+	var err error
+	if params.PublicMetadata, err = metadataPatch(priorPublic, m.expandPublicMetadata(diags)); err != nil {
+		diags.AddError("Error merging public_metadata", "Could not compute the public_metadata changes: "+err.Error())
+	}
+	if params.PrivateMetadata, err = metadataPatch(priorPrivate, m.expandPrivateMetadata(diags)); err != nil {
+		diags.AddError("Error merging private_metadata", "Could not compute the private_metadata changes: "+err.Error())
+	}
+	return params
+}
+
+// setOrganization copies the attributes returned by the Clerk API into the
+// model. Metadata is written to whichever form is in use, and both forms are
+// kept null while Terraform does not manage the metadata. In merge mode only the keys
+// Terraform owns are kept, so keys written by other clients never show up
+// as drift.
+func (m *organizationResourceModel) setOrganization(org *clerk.Organization) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	publicMetadata, privateMetadata := org.PublicMetadata, org.PrivateMetadata
+	if m.MetadataManagement.ValueString() == metadataManagementMerge {
+		var err error
+		if publicMetadata, err = filterMetadata(publicMetadata, m.expandPublicMetadata(&diags)); err != nil {
+			diags.AddError("Error filtering public_metadata", "Could not filter public_metadata: "+err.Error())
+		}
+		if privateMetadata, err = filterMetadata(privateMetadata, m.expandPrivateMetadata(&diags)); err != nil {
+			diags.AddError("Error filtering private_metadata", "Could not filter private_metadata: "+err.Error())
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	m.Name = types.StringValue(org.Name)
+	m.Slug = types.StringValue(org.Slug)
+
+	// Always set a known value for max_allowed_memberships
+	if org.MaxAllowedMemberships > 0 {
+		m.MaxAllowedMemberships = types.Int64Value(org.MaxAllowedMemberships)
+	} else {
+		m.MaxAllowedMemberships = types.Int64Null()
+	}
+
+	switch {
+	case !m.PublicMetadataObject.IsNull():
+		m.PublicMetadata = jsonStringNull()
+		m.PublicMetadataObject = flattenMetadataObject(publicMetadata, m.PublicMetadataObject, "public_metadata_object", &diags)
+	case !m.PublicMetadata.IsNull():
+		m.PublicMetadata = flattenMetadata(publicMetadata)
+	}
+
+	switch {
+	case !m.PrivateMetadataObject.IsNull():
+		m.PrivateMetadata = jsonStringNull()
+		m.PrivateMetadataObject = flattenMetadataObject(privateMetadata, m.PrivateMetadataObject, "private_metadata_object", &diags)
+	case !m.PrivateMetadata.IsNull():
+		m.PrivateMetadata = flattenMetadata(privateMetadata)
+	}
+
+	m.CreatedAt = timestampValue(org.CreatedAt)
+	m.UpdatedAt = timestampValue(org.UpdatedAt)
+	m.MembersCount = types.Int64PointerValue(org.MembersCount)
+	m.PendingInvitationsCount = types.Int64PointerValue(org.PendingInvitationsCount)
+	m.HasImage = types.BoolValue(org.HasImage)
+	m.ImageURL = types.StringPointerValue(org.ImageURL)
+	m.AdminDeleteEnabled = types.BoolValue(org.AdminDeleteEnabled)
+
+	return diags
 }
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/organizationdomain"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &organizationDomainResource{}
+	_ resource.ResourceWithConfigure   = &organizationDomainResource{}
+	_ resource.ResourceWithImportState = &organizationDomainResource{}
+)
+
+// NewOrganizationDomainResource is a helper function to simplify the provider implementation
+func NewOrganizationDomainResource() resource.Resource {
+	return &organizationDomainResource{}
+}
+
+// organizationDomainResource is the resource implementation
+type organizationDomainResource struct {
+	client *ClerkClient
+}
+
+// organizationDomainResourceModel describes the resource data model
+type organizationDomainResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	OrganizationID          types.String `tfsdk:"organization_id"`
+	Name                    types.String `tfsdk:"name"`
+	EnrollmentMode          types.String `tfsdk:"enrollment_mode"`
+	Verified                types.Bool   `tfsdk:"verified"`
+	VerificationStatus      types.String `tfsdk:"verification_status"`
+	VerificationStrategy    types.String `tfsdk:"verification_strategy"`
+	AffiliationEmailAddress types.String `tfsdk:"affiliation_email_address"`
+}
+
+// Metadata returns the resource type name
+func (r *organizationDomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_domain"
+}
+
+// Schema defines the schema for the resource
+func (r *organizationDomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a domain attached to a Clerk organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the organization domain.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the organization the domain belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The domain name, e.g. example.com.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enrollment_mode": schema.StringAttribute{
+				Description: "How users with an email address on this domain join the organization: " +
+					"manual_invitation, automatic_invitation or automatic_suggestion.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether the domain has been verified.",
+				Computed:    true,
+			},
+			"verification_status": schema.StringAttribute{
+				Description: "The verification status of the domain, e.g. verified or unverified.",
+				Computed:    true,
+			},
+			"verification_strategy": schema.StringAttribute{
+				Description: "The strategy used to verify the domain, e.g. email_code.",
+				Computed:    true,
+			},
+			"affiliation_email_address": schema.StringAttribute{
+				Description: "The email address used to verify affiliation with the domain.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *organizationDomainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *ClerkClient, got something else. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state
+func (r *organizationDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan organizationDomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the domain parameters
+	params := &organizationdomain.CreateParams{
+		Name: clerk.String(plan.Name.ValueString()),
+	}
+
+	if !plan.EnrollmentMode.IsNull() && !plan.EnrollmentMode.IsUnknown() {
+		params.EnrollmentMode = clerk.String(plan.EnrollmentMode.ValueString())
+	}
+
+	// Attach the domain to the organization
+	domain, err := r.client.CreateOrganizationDomain(ctx, plan.OrganizationID.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating organization domain",
+			"Could not add domain "+plan.Name.ValueString()+" to organization "+plan.OrganizationID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	plan.ID = types.StringValue(domain.ID)
+	plan.setDomain(domain)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data
+func (r *organizationDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state organizationDomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up the domain in Clerk
+	domain, err := r.client.GetOrganizationDomain(ctx, state.OrganizationID.ValueString(), state.ID.ValueString())
+	if isNotFound(err) {
+		// The organization itself no longer exists
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading organization domain",
+			"Could not read organization domain ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// The domain was removed from the organization, so let Terraform plan to add it again
+	if domain == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state with refreshed values
+	state.setDomain(domain)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success
+func (r *organizationDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan organizationDomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the enrollment mode can change in place
+	params := &organizationdomain.UpdateParams{
+		OrganizationID: plan.OrganizationID.ValueString(),
+		DomainID:       plan.ID.ValueString(),
+	}
+
+	if !plan.EnrollmentMode.IsNull() && !plan.EnrollmentMode.IsUnknown() {
+		params.EnrollmentMode = clerk.String(plan.EnrollmentMode.ValueString())
+	}
+
+	domain, err := r.client.UpdateOrganizationDomain(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating organization domain",
+			"Could not update organization domain ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	plan.setDomain(domain)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success
+func (r *organizationDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state organizationDomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the domain from the organization
+	err := r.client.DeleteOrganizationDomain(ctx, &organizationdomain.DeleteParams{
+		OrganizationID: state.OrganizationID.ValueString(),
+		DomainID:       state.ID.ValueString(),
+	})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting organization domain",
+			"Could not delete organization domain ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform state
+func (r *organizationDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Domains are only addressable within their organization
+	organizationID, id, ok := strings.Cut(req.ID, "/")
+	if !ok || organizationID == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: organization_id/domain_id. Got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// setDomain copies the attributes returned by the Clerk API into the model.
+// Domain names are case-insensitive and Clerk stores them in lower case, so
+// the prior spelling is kept to avoid replacing the domain on every plan.
+func (m *organizationDomainResourceModel) setDomain(domain *clerk.OrganizationDomain) {
+	if !strings.EqualFold(m.Name.ValueString(), domain.Name) {
+		m.Name = types.StringValue(domain.Name)
+	}
+	m.EnrollmentMode = types.StringValue(domain.EnrollmentMode)
+	m.AffiliationEmailAddress = types.StringPointerValue(domain.AffiliationEmailAddress)
+
+	if domain.Verification != nil {
+		m.Verified = types.BoolValue(domain.Verification.Status == "verified")
+		m.VerificationStatus = types.StringValue(domain.Verification.Status)
+		m.VerificationStrategy = types.StringValue(domain.Verification.Strategy)
+	} else {
+		m.Verified = types.BoolValue(false)
+		m.VerificationStatus = types.StringNull()
+		m.VerificationStrategy = types.StringNull()
+	}
+}
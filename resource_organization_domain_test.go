@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccOrganizationDomainResource(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("domain-org-%s", rString)
+	domain := fmt.Sprintf("tf-%s.example.com", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationDomainResourceConfig(slug, domain, "manual_invitation"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization_domain.test", "name", domain),
+					resource.TestCheckResourceAttr("clerk_organization_domain.test", "enrollment_mode", "manual_invitation"),
+					resource.TestCheckResourceAttr("clerk_organization_domain.test", "verified", "false"),
+					resource.TestCheckResourceAttrSet("clerk_organization_domain.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "clerk_organization_domain.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["clerk_organization_domain.test"]
+					return rs.Primary.Attributes["organization_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			// Update enrollment mode testing
+			{
+				Config: testAccOrganizationDomainResourceConfig(slug, domain, "automatic_suggestion"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization_domain.test", "enrollment_mode", "automatic_suggestion"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationDomainResource_mixedCase(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("domain-case-org-%s", rString)
+	// Clerk stores domain names in lower case, so the configured spelling
+	// must be kept in state without planning a replacement
+	domain := fmt.Sprintf("TF-%s.Example.com", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationDomainResourceConfig(slug, domain, "manual_invitation"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization_domain.test", "name", domain),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationDomainResourceConfig(slug, domain, enrollmentMode string) string {
+	return fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  name = "Domain Org"
+  slug = %[1]q
+}
+
+resource "clerk_organization_domain" "test" {
+  organization_id = clerk_organization.test.id
+  name            = %[2]q
+  enrollment_mode = %[3]q
+}
+`, slug, domain, enrollmentMode)
+}
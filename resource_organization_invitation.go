@@ -0,0 +1,365 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/organizationinvitation"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Organization invitation statuses reported by the Clerk API
+const (
+	invitationStatusPending  = "pending"
+	invitationStatusAccepted = "accepted"
+	invitationStatusRevoked  = "revoked"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &organizationInvitationResource{}
+	_ resource.ResourceWithConfigure   = &organizationInvitationResource{}
+	_ resource.ResourceWithImportState = &organizationInvitationResource{}
+)
+
+// NewOrganizationInvitationResource is a helper function to simplify the provider implementation
+func NewOrganizationInvitationResource() resource.Resource {
+	return &organizationInvitationResource{}
+}
+
+// organizationInvitationResource is the resource implementation
+type organizationInvitationResource struct {
+	client *ClerkClient
+}
+
+// organizationInvitationResourceModel describes the resource data model
+type organizationInvitationResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	OrganizationID  types.String `tfsdk:"organization_id"`
+	EmailAddress    types.String `tfsdk:"email_address"`
+	Role            types.String `tfsdk:"role"`
+	RedirectURL     types.String `tfsdk:"redirect_url"`
+	InviterUserID   types.String `tfsdk:"inviter_user_id"`
+	PublicMetadata  jsonString   `tfsdk:"public_metadata"`
+	PrivateMetadata jsonString   `tfsdk:"private_metadata"`
+	Status          types.String `tfsdk:"status"`
+}
+
+// requiresReplaceUnlessAccepted forces a new invitation when an argument
+// changes, unless the invitation has already been accepted. An accepted
+// invitation has turned into a membership, so sending a new one would only
+// re-invite a user who is already part of the organization.
+func requiresReplaceUnlessAccepted() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			var status types.String
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("status"), &status)...)
+			resp.RequiresReplace = status.ValueString() != invitationStatusAccepted
+		},
+		"Changing this value sends a new invitation, unless the invitation has already been accepted.",
+		"Changing this value sends a new invitation, unless the invitation has already been accepted.",
+	)
+}
+
+// Metadata returns the resource type name
+func (r *organizationInvitationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invitation"
+}
+
+// Schema defines the schema for the resource
+func (r *organizationInvitationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an invitation to join a Clerk organization. " +
+			"The invitation is revoked when the resource is destroyed while still pending, and sent again when " +
+			"it is revoked outside Terraform. Once accepted, the invitation is kept in state and is never sent again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the invitation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the organization the invitation is for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email_address": schema.StringAttribute{
+				Description: "The email address of the person being invited.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessAccepted(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role the invited user receives once they accept, e.g. org:admin or org:member.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessAccepted(),
+				},
+			},
+			"redirect_url": schema.StringAttribute{
+				Description: "The URL the invited user is redirected to after clicking the invitation link.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessAccepted(),
+				},
+			},
+			"inviter_user_id": schema.StringAttribute{
+				Description: "The ID of the user sending the invitation.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessAccepted(),
+				},
+			},
+			"public_metadata": schema.StringAttribute{
+				Description: "Public metadata for the invitation (JSON string). Copied to the membership once accepted.",
+				Optional:    true,
+				CustomType:  jsonStringType{},
+				PlanModifiers: []planmodifier.String{
+					jsonSemanticEquality(),
+					requiresReplaceUnlessAccepted(),
+				},
+			},
+			"private_metadata": schema.StringAttribute{
+				Description: "Private metadata for the invitation (JSON string). Copied to the membership once accepted.",
+				Optional:    true,
+				Sensitive:   true,
+				CustomType:  jsonStringType{},
+				PlanModifiers: []planmodifier.String{
+					jsonSemanticEquality(),
+					requiresReplaceUnlessAccepted(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the invitation: pending, accepted or revoked.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *organizationInvitationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *ClerkClient, got something else. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state
+func (r *organizationInvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan organizationInvitationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the invitation parameters
+	params := &organizationinvitation.CreateParams{
+		OrganizationID: plan.OrganizationID.ValueString(),
+		EmailAddress:   clerk.String(plan.EmailAddress.ValueString()),
+		Role:           clerk.String(plan.Role.ValueString()),
+	}
+
+	if !plan.RedirectURL.IsNull() && !plan.RedirectURL.IsUnknown() {
+		params.RedirectURL = clerk.String(plan.RedirectURL.ValueString())
+	}
+
+	if !plan.InviterUserID.IsNull() && !plan.InviterUserID.IsUnknown() {
+		params.InviterUserID = clerk.String(plan.InviterUserID.ValueString())
+	}
+
+	params.PublicMetadata = expandMetadata(plan.PublicMetadata, "public_metadata", &resp.Diagnostics)
+	params.PrivateMetadata = expandMetadata(plan.PrivateMetadata, "private_metadata", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Send the invitation
+	invitation, err := r.client.CreateOrganizationInvitation(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating organization invitation",
+			"Could not invite "+plan.EmailAddress.ValueString()+" to organization "+plan.OrganizationID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	plan.ID = types.StringValue(invitation.ID)
+	plan.Status = types.StringValue(invitation.Status)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data
+func (r *organizationInvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state organizationInvitationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the invitation from Clerk
+	invitation, err := r.client.GetOrganizationInvitation(ctx, state.OrganizationID.ValueString(), state.ID.ValueString())
+	if isNotFound(err) {
+		// The invitation or its organization no longer exists
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading organization invitation",
+			"Could not read organization invitation ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// An invitation revoked outside Terraform can no longer be accepted, so
+	// let Terraform plan to send it again
+	if invitation.Status == invitationStatusRevoked {
+		tflog.Warn(ctx, "Organization invitation revoked, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state with refreshed values. An accepted invitation stays in
+	// state, so it is never sent again.
+	state.Status = types.StringValue(invitation.Status)
+
+	// Update only records changes to an accepted invitation in state, so
+	// keep the recorded values rather than reverting them to the ones the
+	// invitation was sent with. After an import they come from the API.
+	if invitation.Status != invitationStatusAccepted || state.EmailAddress.IsNull() {
+		state.EmailAddress = types.StringValue(invitation.EmailAddress)
+		state.Role = types.StringValue(invitation.Role)
+
+		// Keep the prior values when Clerk does not report these fields
+		if invitation.RedirectURL != nil {
+			state.RedirectURL = types.StringValue(*invitation.RedirectURL)
+		}
+		if invitation.InviterUserID != nil {
+			state.InviterUserID = types.StringValue(*invitation.InviterUserID)
+		}
+	}
+
+	// Metadata cannot change after creation, so it is only populated from
+	// the API when missing from state, e.g. after an import
+	if state.PublicMetadata.IsNull() {
+		state.PublicMetadata = invitationMetadataValue(invitation.PublicMetadata)
+	}
+	if state.PrivateMetadata.IsNull() {
+		state.PrivateMetadata = invitationMetadataValue(invitation.PrivateMetadata)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// invitationMetadataValue converts invitation metadata returned by the API
+// to a state value, treating an empty object as unset.
+func invitationMetadataValue(raw json.RawMessage) jsonString {
+	if jsonSemanticEquals(string(raw), "{}") || jsonSemanticEquals(string(raw), "null") {
+		return jsonStringNull()
+	}
+	return flattenMetadata(raw)
+}
+
+// Update is only reached for accepted invitations, since any other change
+// forces a new invitation. Clerk has no API to modify an invitation, so the
+// new values are recorded in state without calling the API.
+func (r *organizationInvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan organizationInvitationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Organization invitation already accepted",
+		"Invitation ID "+plan.ID.ValueString()+" has already been accepted, so the changes were only recorded in state. "+
+			"Use clerk_organization_membership to manage the resulting membership.",
+	)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success
+func (r *organizationInvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state organizationInvitationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only pending invitations can be revoked. Accepted and revoked
+	// invitations are simply removed from state.
+	if state.Status.ValueString() != invitationStatusPending {
+		return
+	}
+
+	_, err := r.client.RevokeOrganizationInvitation(ctx, &organizationinvitation.RevokeParams{
+		OrganizationID: state.OrganizationID.ValueString(),
+		ID:             state.ID.ValueString(),
+	})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error revoking organization invitation",
+			"Could not revoke organization invitation ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform state
+func (r *organizationInvitationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Invitations are only addressable within their organization
+	organizationID, id, ok := strings.Cut(req.ID, "/")
+	if !ok || organizationID == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: organization_id/invitation_id. Got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
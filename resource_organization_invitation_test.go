@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/clerk/clerk-sdk-go/v2/organizationinvitation"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccOrganizationInvitationResource(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("invitation-org-%s", rString)
+	email := fmt.Sprintf("invitee+%s@example.com", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationInvitationResourceConfig(slug, email, "org:member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization_invitation.test", "email_address", email),
+					resource.TestCheckResourceAttr("clerk_organization_invitation.test", "role", "org:member"),
+					resource.TestCheckResourceAttr("clerk_organization_invitation.test", "status", "pending"),
+					resource.TestCheckResourceAttrSet("clerk_organization_invitation.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "clerk_organization_invitation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["clerk_organization_invitation.test"]
+					return rs.Primary.Attributes["organization_id"] + "/" + rs.Primary.ID, nil
+				},
+			},
+			// Changing the role of a pending invitation sends a new one
+			{
+				Config: testAccOrganizationInvitationResourceConfig(slug, email, "org:admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization_invitation.test", "role", "org:admin"),
+					resource.TestCheckResourceAttr("clerk_organization_invitation.test", "status", "pending"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationInvitationResource_revoked(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("revoked-invitation-org-%s", rString)
+	email := fmt.Sprintf("revoked+%s@example.com", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Revoke the invitation outside Terraform after sending it
+			{
+				Config: testAccOrganizationInvitationResourceConfig(slug, email, "org:member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationInvitationRevoked("clerk_organization_invitation.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// The next apply sends a new invitation
+			{
+				Config: testAccOrganizationInvitationResourceConfig(slug, email, "org:member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization_invitation.test", "status", "pending"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckOrganizationInvitationRevoked revokes the invitation directly
+// through the API, as if someone revoked it in the Clerk dashboard
+func testAccCheckOrganizationInvitationRevoked(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		_, err := testAccClerkClient().RevokeOrganizationInvitation(context.Background(), &organizationinvitation.RevokeParams{
+			OrganizationID: rs.Primary.Attributes["organization_id"],
+			ID:             rs.Primary.ID,
+		})
+		return err
+	}
+}
+
+func testAccOrganizationInvitationResourceConfig(slug, email, role string) string {
+	return fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  name = "Invitation Org"
+  slug = %[1]q
+}
+
+resource "clerk_organization_invitation" "test" {
+  organization_id = clerk_organization.test.id
+  email_address   = %[2]q
+  role            = %[3]q
+
+  public_metadata = jsonencode({
+    source = "terraform"
+  })
+}
+`, slug, email, role)
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/organizationmembership"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &organizationMembershipResource{}
+	_ resource.ResourceWithConfigure   = &organizationMembershipResource{}
+	_ resource.ResourceWithImportState = &organizationMembershipResource{}
+)
+
+// NewOrganizationMembershipResource is a helper function to simplify the provider implementation
+func NewOrganizationMembershipResource() resource.Resource {
+	return &organizationMembershipResource{}
+}
+
+// organizationMembershipResource is the resource implementation
+type organizationMembershipResource struct {
+	client *ClerkClient
+}
+
+// organizationMembershipResourceModel describes the resource data model
+type organizationMembershipResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	UserID         types.String `tfsdk:"user_id"`
+	Role           types.String `tfsdk:"role"`
+}
+
+// Metadata returns the resource type name
+func (r *organizationMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_membership"
+}
+
+// Schema defines the schema for the resource
+func (r *organizationMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the membership of a user in a Clerk organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the organization membership.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the organization the user belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user who is a member of the organization.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The role of the user in the organization, e.g. org:admin or org:member.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *organizationMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *ClerkClient, got something else. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state
+func (r *organizationMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan organizationMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add the user to the organization
+	membership, err := r.client.CreateOrganizationMembership(ctx, &organizationmembership.CreateParams{
+		OrganizationID: plan.OrganizationID.ValueString(),
+		UserID:         clerk.String(plan.UserID.ValueString()),
+		Role:           clerk.String(plan.Role.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating organization membership",
+			"Could not add user "+plan.UserID.ValueString()+" to organization "+plan.OrganizationID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	plan.ID = types.StringValue(membership.ID)
+	plan.Role = types.StringValue(membership.Role)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data
+func (r *organizationMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state organizationMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up the membership in Clerk
+	membership, err := r.client.GetOrganizationMembership(ctx, state.OrganizationID.ValueString(), state.UserID.ValueString())
+	if isNotFound(err) {
+		// The organization itself no longer exists
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading organization membership",
+			"Could not read membership of user "+state.UserID.ValueString()+" in organization "+state.OrganizationID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// The user is no longer a member, so let Terraform plan to add them again
+	if membership == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Update state with refreshed values
+	state.ID = types.StringValue(membership.ID)
+	state.Role = types.StringValue(membership.Role)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success
+func (r *organizationMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan organizationMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the role can change in place; the organization and user force a replacement
+	membership, err := r.client.UpdateOrganizationMembership(ctx, &organizationmembership.UpdateParams{
+		OrganizationID: plan.OrganizationID.ValueString(),
+		UserID:         plan.UserID.ValueString(),
+		Role:           clerk.String(plan.Role.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating organization membership",
+			"Could not update membership of user "+plan.UserID.ValueString()+" in organization "+plan.OrganizationID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	plan.ID = types.StringValue(membership.ID)
+	plan.Role = types.StringValue(membership.Role)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success
+func (r *organizationMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state organizationMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the user from the organization
+	err := r.client.DeleteOrganizationMembership(ctx, &organizationmembership.DeleteParams{
+		OrganizationID: state.OrganizationID.ValueString(),
+		UserID:         state.UserID.ValueString(),
+	})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting organization membership",
+			"Could not remove user "+state.UserID.ValueString()+" from organization "+state.OrganizationID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform state
+func (r *organizationMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Memberships are identified by the organization and user they link
+	organizationID, userID, ok := strings.Cut(req.ID, "/")
+	if !ok || organizationID == "" || userID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: organization_id/user_id. Got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), organizationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+}
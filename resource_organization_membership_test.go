@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccOrganizationMembershipResource(t *testing.T) {
+	userID := os.Getenv("CLERK_TEST_USER_ID")
+	if userID == "" {
+		t.Skip("CLERK_TEST_USER_ID must be set to run organization membership acceptance tests")
+	}
+
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("membership-org-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationMembershipResourceConfig(slug, userID, "org:member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization_membership.test", "user_id", userID),
+					resource.TestCheckResourceAttr("clerk_organization_membership.test", "role", "org:member"),
+					resource.TestCheckResourceAttrPair("clerk_organization_membership.test", "organization_id", "clerk_organization.test", "id"),
+					resource.TestCheckResourceAttrSet("clerk_organization_membership.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "clerk_organization_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["clerk_organization_membership.test"]
+					return rs.Primary.Attributes["organization_id"] + "/" + rs.Primary.Attributes["user_id"], nil
+				},
+			},
+			// Update role testing
+			{
+				Config: testAccOrganizationMembershipResourceConfig(slug, userID, "org:admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization_membership.test", "role", "org:admin"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationMembershipResourceConfig(slug, userID, role string) string {
+	return fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  name = "Membership Org"
+  slug = %[1]q
+}
+
+resource "clerk_organization_membership" "test" {
+  organization_id = clerk_organization.test.id
+  user_id         = %[2]q
+  role            = %[3]q
+}
+`, slug, userID, role)
+}
@@ -1,15 +1,22 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/clerk/clerk-sdk-go/v2/organization"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccOrganizationResource(t *testing.T) {
-	rString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 	slug1 := fmt.Sprintf("test-org-%s", rString)
 	slug2 := fmt.Sprintf("test-org-updated-%s", rString)
 
@@ -24,6 +31,12 @@ func TestAccOrganizationResource(t *testing.T) {
 					resource.TestCheckResourceAttr("clerk_organization.test", "name", "Test Org"),
 					resource.TestCheckResourceAttr("clerk_organization.test", "slug", slug1),
 					resource.TestCheckResourceAttrSet("clerk_organization.test", "id"),
+					resource.TestCheckResourceAttrSet("clerk_organization.test", "created_at"),
+					resource.TestCheckResourceAttrSet("clerk_organization.test", "updated_at"),
+					resource.TestCheckResourceAttr("clerk_organization.test", "members_count", "0"),
+					resource.TestCheckResourceAttr("clerk_organization.test", "pending_invitations_count", "0"),
+					resource.TestCheckResourceAttr("clerk_organization.test", "has_image", "false"),
+					resource.TestCheckResourceAttrSet("clerk_organization.test", "admin_delete_enabled"),
 				),
 			},
 			// ImportState testing
@@ -32,12 +45,21 @@ func TestAccOrganizationResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
-			// Update and Read testing
+			// ImportState testing by slug
+			{
+				ResourceName:      "clerk_organization.test",
+				ImportState:       true,
+				ImportStateId:     "slug:" + slug1,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing. Renaming an organization without a
+			// logo changes its generated image.
 			{
 				Config: testAccOrganizationResourceConfig("Test Org Updated", slug2),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("clerk_organization.test", "name", "Test Org Updated"),
 					resource.TestCheckResourceAttr("clerk_organization.test", "slug", slug2),
+					resource.TestCheckResourceAttrSet("clerk_organization.test", "image_url"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -46,7 +68,7 @@ func TestAccOrganizationResource(t *testing.T) {
 }
 
 func TestAccOrganizationResource_withMetadata(t *testing.T) {
-	rString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 	slug := fmt.Sprintf("metadata-org-%s", rString)
 
 	resource.Test(t, resource.TestCase{
@@ -66,8 +88,285 @@ func TestAccOrganizationResource_withMetadata(t *testing.T) {
 	})
 }
 
+func TestAccOrganizationResource_deletionProtection(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("protected-org-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a protected organization
+			{
+				Config: testAccOrganizationResourceConfigWithDeletionProtection(slug, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.test", "deletion_protection", "true"),
+				),
+			},
+			// Destroying it fails
+			{
+				Config:      testAccOrganizationResourceConfigWithDeletionProtection(slug, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`has deletion_protection enabled`),
+			},
+			// Lift the protection so the organization can be destroyed
+			{
+				Config: testAccOrganizationResourceConfigWithDeletionProtection(slug, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.test", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationResource_deletionProtectionProviderDefault(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("protected-default-org-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The provider default applies when the attribute is not set
+			{
+				Config: `
+provider "clerk" {
+  deletion_protection = true
+}
+` + testAccOrganizationResourceConfig("Protected Default Org", slug),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.test", "deletion_protection", "true"),
+				),
+			},
+			// Without the provider default the organization can be destroyed again
+			{
+				Config: testAccOrganizationResourceConfig("Protected Default Org", slug),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.test", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationResource_removeMetadata(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("remove-metadata-org-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with metadata
+			{
+				Config: testAccOrganizationResourceConfigWithMetadata("Remove Metadata Org", slug),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationPublicMetadata("clerk_organization.test", `{"environment":"test","region":"us-west-1"}`),
+				),
+			},
+			// Removing the attributes clears the metadata in Clerk once
+			{
+				Config: testAccOrganizationResourceConfig("Remove Metadata Org", slug),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("clerk_organization.test", "public_metadata"),
+					resource.TestCheckNoResourceAttr("clerk_organization.test", "private_metadata"),
+					testAccCheckOrganizationPublicMetadata("clerk_organization.test", `{}`),
+					testAccCheckOrganizationMergeMetadata("clerk_organization.test", `{"billing_status":"active"}`),
+				),
+			},
+			// Metadata written by the application afterwards is left untouched
+			{
+				Config: testAccOrganizationResourceConfig("Remove Metadata Org Renamed", slug),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("clerk_organization.test", "public_metadata"),
+					testAccCheckOrganizationPublicMetadata("clerk_organization.test", `{"billing_status":"active"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationResource_metadataFormatting(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("metadata-fmt-org-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with hand-formatted JSON
+			{
+				Config: testAccOrganizationResourceConfigWithRawMetadata(slug, `{
+  "tier": "premium",
+  "seats": 10,
+  "billing": { "plan": "pro", "annual": true }
+}`),
+			},
+			// Reordering keys, reformatting numbers and dropping whitespace plans no change
+			{
+				Config:   testAccOrganizationResourceConfigWithRawMetadata(slug, `{"billing":{"annual":true,"plan":"pro"},"seats":1e1,"tier":"premium"}`),
+				PlanOnly: true,
+			},
+			// A real change is still detected
+			{
+				Config: testAccOrganizationResourceConfigWithRawMetadata(slug, `{"billing":{"annual":true,"plan":"pro"},"seats":20,"tier":"premium"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("clerk_organization.test", "public_metadata", regexp.MustCompile(`"seats":20`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationResource_withMetadataObject(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("metadata-obj-org-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with object metadata
+			{
+				Config: testAccOrganizationResourceConfigWithMetadataObject(slug, "premium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.test", "public_metadata_object.tier", "premium"),
+					resource.TestCheckResourceAttr("clerk_organization.test", "public_metadata_object.seats", "10"),
+					resource.TestCheckResourceAttr("clerk_organization.test", "public_metadata_object.regions.#", "2"),
+					resource.TestCheckResourceAttr("clerk_organization.test", "private_metadata_object.billing.customer_id", "cus_123"),
+					resource.TestCheckNoResourceAttr("clerk_organization.test", "public_metadata"),
+				),
+			},
+			// Change a single key
+			{
+				Config: testAccOrganizationResourceConfigWithMetadataObject(slug, "enterprise"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.test", "public_metadata_object.tier", "enterprise"),
+				),
+			},
+			// Setting both forms is rejected
+			{
+				Config: fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  name                   = "Metadata Object Org"
+  slug                   = %[1]q
+  public_metadata        = jsonencode({ tier = "premium" })
+  public_metadata_object = { tier = "premium" }
+}
+`, slug),
+				ExpectError: regexp.MustCompile(`Only one of public_metadata or public_metadata_object can be set`),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationResource_slugValidation(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("test-org-slug-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid slugs are rejected before anything is created
+			{
+				Config:      testAccOrganizationResourceConfig("Test Org Slug", "Not A Slug"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Slug`),
+			},
+			{
+				Config: testAccOrganizationResourceConfig("Test Org Slug", slug),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.test", "slug", slug),
+				),
+			},
+			// A second organization cannot take the same slug
+			{
+				Config:      testAccOrganizationResourceConfigWithDuplicateSlug(slug),
+				ExpectError: regexp.MustCompile(`Slug Already In Use`),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationResource_adminDeleteEnabled(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("test-org-admin-delete-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationResourceConfigWithAdminDelete(slug, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.test", "admin_delete_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "clerk_organization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationResourceConfigWithAdminDelete(slug, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.test", "admin_delete_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationResource_logo(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("test-org-logo-%s", rString)
+
+	// Terraform runs in a temporary directory, so the logos need absolute paths
+	logo, err := filepath.Abs("testdata/logo.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	altLogo, err := filepath.Abs("testdata/logo-alt.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationResourceConfigWithLogo(slug, logo),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.test", "has_image", "true"),
+					resource.TestCheckResourceAttrSet("clerk_organization.test", "image_url"),
+					resource.TestCheckResourceAttrSet("clerk_organization.test", "logo_sha256"),
+				),
+			},
+			// Replacing the file uploads the new logo
+			{
+				Config: testAccOrganizationResourceConfigWithLogo(slug, altLogo),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.test", "has_image", "true"),
+					resource.TestCheckResourceAttr("clerk_organization.test", "logo_path", altLogo),
+				),
+			},
+			// Removing logo_path deletes the logo
+			{
+				Config: testAccOrganizationResourceConfig("Test Org Logo", slug),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.test", "has_image", "false"),
+					resource.TestCheckNoResourceAttr("clerk_organization.test", "logo_sha256"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccOrganizationResource_minimal(t *testing.T) {
-	rString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 	name := fmt.Sprintf("Minimal Org %s", rString)
 
 	resource.Test(t, resource.TestCase{
@@ -88,7 +387,7 @@ func TestAccOrganizationResource_minimal(t *testing.T) {
 }
 
 func TestAccOrganizationResource_withMaxMemberships(t *testing.T) {
-	rString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 	slug := fmt.Sprintf("max-org-%s", rString)
 
 	resource.Test(t, resource.TestCase{
@@ -114,6 +413,113 @@ func TestAccOrganizationResource_withMaxMemberships(t *testing.T) {
 	})
 }
 
+func TestAccOrganizationResource_disappears(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("disappears-org-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Delete the organization outside Terraform after creating it
+			{
+				Config: testAccOrganizationResourceConfig("Disappears Org", slug),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationDisappears("clerk_organization.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOrganizationResource_metadataMerge(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	slug := fmt.Sprintf("merge-org-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with a Terraform-owned key, then let the application add its own
+			{
+				Config: testAccOrganizationResourceConfigWithMergedMetadata(slug, `tier = "premium"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.test", "metadata_management", "merge"),
+					testAccCheckOrganizationMergeMetadata("clerk_organization.test", `{"billing_status":"active"}`),
+				),
+			},
+			// The application's key does not show up as drift
+			{
+				Config:   testAccOrganizationResourceConfigWithMergedMetadata(slug, `tier = "premium"`),
+				PlanOnly: true,
+			},
+			// Changing and adding owned keys leaves the application's key in place
+			{
+				Config: testAccOrganizationResourceConfigWithMergedMetadata(slug, `tier = "enterprise", seats = 10`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_organization.test", "public_metadata", `{"seats":10,"tier":"enterprise"}`),
+					testAccCheckOrganizationPublicMetadata("clerk_organization.test", `{"billing_status":"active","seats":10,"tier":"enterprise"}`),
+				),
+			},
+			// Removing an owned key deletes only that key
+			{
+				Config: testAccOrganizationResourceConfigWithMergedMetadata(slug, `tier = "enterprise"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOrganizationPublicMetadata("clerk_organization.test", `{"billing_status":"active","tier":"enterprise"}`),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckOrganizationMergeMetadata merges public metadata directly
+// through the API, as an application writing its own keys would
+func testAccCheckOrganizationMergeMetadata(resourceName, metadata string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		raw := json.RawMessage(metadata)
+		_, err := testAccClerkClient().UpdateOrganizationMetadata(context.Background(), rs.Primary.ID, &organization.UpdateMetadataParams{
+			PublicMetadata: &raw,
+		})
+		return err
+	}
+}
+
+// testAccCheckOrganizationPublicMetadata checks the full public metadata
+// stored in Clerk, including keys Terraform does not own
+func testAccCheckOrganizationPublicMetadata(resourceName, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		org, err := testAccClerkClient().GetOrganization(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !jsonSemanticEquals(string(org.PublicMetadata), want) {
+			return fmt.Errorf("expected public metadata %s, got %s", want, org.PublicMetadata)
+		}
+		return nil
+	}
+}
+
+// testAccCheckOrganizationDisappears deletes the organization directly
+// through the API, as if someone removed it in the Clerk dashboard
+func testAccCheckOrganizationDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return testAccClerkClient().DeleteOrganization(context.Background(), rs.Primary.ID)
+	}
+}
+
 // Test configuration functions
 
 func testAccOrganizationResourceConfig(name, slug string) string {
@@ -125,6 +531,15 @@ resource "clerk_organization" "test" {
 `, name, slug)
 }
 
+func testAccOrganizationResourceConfigWithDuplicateSlug(slug string) string {
+	return testAccOrganizationResourceConfig("Test Org Slug", slug) + fmt.Sprintf(`
+resource "clerk_organization" "duplicate" {
+  name = "Test Org Duplicate Slug"
+  slug = %[1]q
+}
+`, slug)
+}
+
 func testAccOrganizationResourceConfigMinimal(name string) string {
 	return fmt.Sprintf(`
 resource "clerk_organization" "test" {
@@ -151,6 +566,82 @@ resource "clerk_organization" "test" {
 `, name, slug)
 }
 
+func testAccOrganizationResourceConfigWithRawMetadata(slug, metadata string) string {
+	return fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  name = "Metadata Format Org"
+  slug = %[1]q
+
+  public_metadata = <<-EOT
+%[2]s
+  EOT
+}
+`, slug, metadata)
+}
+
+func testAccOrganizationResourceConfigWithMetadataObject(slug, tier string) string {
+	return fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  name = "Metadata Object Org"
+  slug = %[1]q
+
+  public_metadata_object = {
+    tier    = %[2]q
+    seats   = 10
+    regions = ["us-west-1", "eu-central-1"]
+  }
+
+  private_metadata_object = {
+    billing = {
+      customer_id = "cus_123"
+    }
+  }
+}
+`, slug, tier)
+}
+
+func testAccOrganizationResourceConfigWithMergedMetadata(slug, metadata string) string {
+	return fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  name                = "Merge Org"
+  slug                = %[1]q
+  metadata_management = "merge"
+
+  public_metadata = jsonencode({ %[2]s })
+}
+`, slug, metadata)
+}
+
+func testAccOrganizationResourceConfigWithDeletionProtection(slug string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  name                = "Protected Org"
+  slug                = %[1]q
+  deletion_protection = %[2]t
+}
+`, slug, deletionProtection)
+}
+
+func testAccOrganizationResourceConfigWithAdminDelete(slug string, adminDeleteEnabled bool) string {
+	return fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  name                 = "Test Org Admin Delete"
+  slug                 = %[1]q
+  admin_delete_enabled = %[2]t
+}
+`, slug, adminDeleteEnabled)
+}
+
+func testAccOrganizationResourceConfigWithLogo(slug, logoPath string) string {
+	return fmt.Sprintf(`
+resource "clerk_organization" "test" {
+  name      = "Test Org Logo"
+  slug      = %[1]q
+  logo_path = %[2]q
+}
+`, slug, logoPath)
+}
+
 func testAccOrganizationResourceConfigWithMax(name, slug string, max int) string {
 	return fmt.Sprintf(`
 resource "clerk_organization" "test" {
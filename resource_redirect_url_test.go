@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccRedirectURLResource(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	url := fmt.Sprintf("tftest%s://oauth-callback", rString)
+	updatedURL := fmt.Sprintf("tftest%s://oauth-callback-updated", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRedirectURLResourceConfig(url),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_redirect_url.test", "url", url),
+					resource.TestCheckResourceAttrSet("clerk_redirect_url.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "clerk_redirect_url.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Changing the URL replaces the redirect URL
+			{
+				Config: testAccRedirectURLResourceConfig(updatedURL),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("clerk_redirect_url.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_redirect_url.test", "url", updatedURL),
+				),
+			},
+		},
+	})
+}
+
+func testAccRedirectURLResourceConfig(url string) string {
+	return fmt.Sprintf(`
+resource "clerk_redirect_url" "test" {
+  url = %[1]q
+}
+`, url)
+}
@@ -0,0 +1,623 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/emailaddress"
+	"github.com/clerk/clerk-sdk-go/v2/phonenumber"
+	"github.com/clerk/clerk-sdk-go/v2/user"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &userResource{}
+	_ resource.ResourceWithConfigure   = &userResource{}
+	_ resource.ResourceWithImportState = &userResource{}
+)
+
+// NewUserResource is a helper function to simplify the provider implementation
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+// userResource is the resource implementation
+type userResource struct {
+	client *ClerkClient
+}
+
+// userResourceModel describes the resource data model
+type userResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	EmailAddresses     types.List   `tfsdk:"email_addresses"`
+	PhoneNumbers       types.List   `tfsdk:"phone_numbers"`
+	Username           types.String `tfsdk:"username"`
+	FirstName          types.String `tfsdk:"first_name"`
+	LastName           types.String `tfsdk:"last_name"`
+	ExternalID         types.String `tfsdk:"external_id"`
+	Password           types.String `tfsdk:"password"`
+	PasswordVersion    types.Int64  `tfsdk:"password_version"`
+	SkipPasswordChecks types.Bool   `tfsdk:"skip_password_checks"`
+	PublicMetadata     jsonString   `tfsdk:"public_metadata"`
+	PrivateMetadata    jsonString   `tfsdk:"private_metadata"`
+	UnsafeMetadata     jsonString   `tfsdk:"unsafe_metadata"`
+}
+
+// Metadata returns the resource type name
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the resource
+func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Clerk user, such as a service account or a seed user.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email_addresses": schema.ListAttribute{
+				Description: "Email addresses of the user. The first one is the primary email address. " +
+					"Addresses are marked as verified when added.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"phone_numbers": schema.ListAttribute{
+				Description: "Phone numbers of the user in E.164 format. The first one is the primary phone number. " +
+					"Numbers are marked as verified when added.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "The username of the user.",
+				Optional:    true,
+			},
+			"first_name": schema.StringAttribute{
+				Description: "The first name of the user.",
+				Optional:    true,
+			},
+			"last_name": schema.StringAttribute{
+				Description: "The last name of the user.",
+				Optional:    true,
+			},
+			"external_id": schema.StringAttribute{
+				Description: "The ID of the user in an external system.",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The password of the user. This value is never stored in state; " +
+					"change password_version to set a new password on an existing user.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"password_version": schema.Int64Attribute{
+				Description: "Changing this value sends the configured password to Clerk again.",
+				Optional:    true,
+			},
+			"skip_password_checks": schema.BoolAttribute{
+				Description: "Skip Clerk's password strength and breach checks when setting the password.",
+				Optional:    true,
+			},
+			"public_metadata": schema.StringAttribute{
+				Description: "Public metadata for the user (JSON string). Removing it from the configuration clears " +
+					"the metadata once; metadata Terraform never set is left untouched.",
+				Optional:   true,
+				Computed:   true,
+				CustomType: jsonStringType{},
+				PlanModifiers: []planmodifier.String{
+					jsonSemanticEquality(),
+					nullWhenUnconfigured(),
+				},
+			},
+			"private_metadata": schema.StringAttribute{
+				Description: "Private metadata for the user (JSON string). Removing it from the configuration clears " +
+					"the metadata once; metadata Terraform never set is left untouched.",
+				Optional:   true,
+				Computed:   true,
+				Sensitive:  true,
+				CustomType: jsonStringType{},
+				PlanModifiers: []planmodifier.String{
+					jsonSemanticEquality(),
+					nullWhenUnconfigured(),
+				},
+			},
+			"unsafe_metadata": schema.StringAttribute{
+				Description: "Unsafe metadata for the user (JSON string). Unsafe metadata can be modified by the user from the frontend. " +
+					"Removing it from the configuration clears the metadata once; metadata Terraform never set is left untouched.",
+				Optional:   true,
+				Computed:   true,
+				CustomType: jsonStringType{},
+				PlanModifiers: []planmodifier.String{
+					jsonSemanticEquality(),
+					nullWhenUnconfigured(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *userResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ClerkClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			"Expected *ClerkClient, got something else. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password is write-only, so it is only available in the configuration
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+	var emailAddresses, phoneNumbers []string
+	resp.Diagnostics.Append(plan.EmailAddresses.ElementsAs(ctx, &emailAddresses, false)...)
+	resp.Diagnostics.Append(plan.PhoneNumbers.ElementsAs(ctx, &phoneNumbers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the user parameters
+	params := &user.CreateParams{}
+
+	if len(emailAddresses) > 0 {
+		params.EmailAddresses = &emailAddresses
+	}
+
+	if len(phoneNumbers) > 0 {
+		params.PhoneNumbers = &phoneNumbers
+	}
+
+	if !plan.Username.IsNull() && !plan.Username.IsUnknown() {
+		params.Username = clerk.String(plan.Username.ValueString())
+	}
+
+	if !plan.FirstName.IsNull() && !plan.FirstName.IsUnknown() {
+		params.FirstName = clerk.String(plan.FirstName.ValueString())
+	}
+
+	if !plan.LastName.IsNull() && !plan.LastName.IsUnknown() {
+		params.LastName = clerk.String(plan.LastName.ValueString())
+	}
+
+	if !plan.ExternalID.IsNull() && !plan.ExternalID.IsUnknown() {
+		params.ExternalID = clerk.String(plan.ExternalID.ValueString())
+	}
+
+	if !password.IsNull() && !password.IsUnknown() {
+		params.Password = clerk.String(password.ValueString())
+		if !plan.SkipPasswordChecks.IsNull() && !plan.SkipPasswordChecks.IsUnknown() {
+			params.SkipPasswordChecks = clerk.Bool(plan.SkipPasswordChecks.ValueBool())
+		}
+	} else {
+		params.SkipPasswordRequirement = clerk.Bool(true)
+	}
+
+	// Parse metadata
+	params.PublicMetadata = expandMetadata(plan.PublicMetadata, "public_metadata", &resp.Diagnostics)
+	params.PrivateMetadata = expandMetadata(plan.PrivateMetadata, "private_metadata", &resp.Diagnostics)
+	params.UnsafeMetadata = expandMetadata(plan.UnsafeMetadata, "unsafe_metadata", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the user
+	usr, err := r.client.CreateUser(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating user",
+			"Could not create user: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	plan.ID = types.StringValue(usr.ID)
+	resp.Diagnostics.Append(plan.setUser(ctx, usr)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Record the metadata Terraform now manages
+	resp.Diagnostics.Append(setManagedMetadata(ctx, resp.Private, plan.managedMetadata())...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the user from Clerk
+	usr, err := r.client.GetUser(ctx, state.ID.ValueString())
+	if isNotFound(err) {
+		// The user was deleted outside Terraform, so let Terraform plan to recreate it
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			"Could not read user ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Only refresh the metadata Terraform manages
+	managed, diags := refreshManagedMetadata(ctx, resp.Private, map[string]json.RawMessage{
+		"public_metadata":  usr.PublicMetadata,
+		"private_metadata": usr.PrivateMetadata,
+		"unsafe_metadata":  usr.UnsafeMetadata,
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.PublicMetadata = refreshMetadata(state.PublicMetadata, usr.PublicMetadata, managed["public_metadata"])
+	state.PrivateMetadata = refreshMetadata(state.PrivateMetadata, usr.PrivateMetadata, managed["private_metadata"])
+	state.UnsafeMetadata = refreshMetadata(state.UnsafeMetadata, usr.UnsafeMetadata, managed["unsafe_metadata"])
+
+	// Update state with refreshed values
+	resp.Diagnostics.Append(state.setUser(ctx, usr)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state userResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+	var planEmails, stateEmails, planPhones, statePhones []string
+	resp.Diagnostics.Append(plan.EmailAddresses.ElementsAs(ctx, &planEmails, false)...)
+	resp.Diagnostics.Append(state.EmailAddresses.ElementsAs(ctx, &stateEmails, false)...)
+	resp.Diagnostics.Append(plan.PhoneNumbers.ElementsAs(ctx, &planPhones, false)...)
+	resp.Diagnostics.Append(state.PhoneNumbers.ElementsAs(ctx, &statePhones, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := plan.ID.ValueString()
+
+	// Add new email addresses and phone numbers first, so the primary
+	// identifiers can be switched before the old ones are removed
+	for _, email := range planEmails {
+		if containsFold(stateEmails, email) {
+			continue
+		}
+		_, err := r.client.CreateEmailAddress(ctx, &emailaddress.CreateParams{
+			UserID:       clerk.String(userID),
+			EmailAddress: clerk.String(email),
+			Verified:     clerk.Bool(true),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error adding email address",
+				"Could not add email address "+email+" to user ID "+userID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	for _, phone := range planPhones {
+		if containsFold(statePhones, phone) {
+			continue
+		}
+		_, err := r.client.CreatePhoneNumber(ctx, &phonenumber.CreateParams{
+			UserID:      clerk.String(userID),
+			PhoneNumber: clerk.String(phone),
+			Verified:    clerk.Bool(true),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error adding phone number",
+				"Could not add phone number "+phone+" to user ID "+userID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Fetch the user to resolve the IDs of the identifiers
+	usr, err := r.client.GetUser(ctx, userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user",
+			"Could not read user ID "+userID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Create the user update parameters
+	params := &user.UpdateParams{
+		Username:   optionalStringUpdate(plan.Username, state.Username),
+		FirstName:  optionalStringUpdate(plan.FirstName, state.FirstName),
+		LastName:   optionalStringUpdate(plan.LastName, state.LastName),
+		ExternalID: optionalStringUpdate(plan.ExternalID, state.ExternalID),
+	}
+
+	if len(planEmails) > 0 {
+		for _, email := range usr.EmailAddresses {
+			if strings.EqualFold(email.EmailAddress, planEmails[0]) {
+				params.PrimaryEmailAddressID = clerk.String(email.ID)
+			}
+		}
+	}
+
+	if len(planPhones) > 0 {
+		for _, phone := range usr.PhoneNumbers {
+			if strings.EqualFold(phone.PhoneNumber, planPhones[0]) {
+				params.PrimaryPhoneNumberID = clerk.String(phone.ID)
+			}
+		}
+	}
+
+	// Only send the password when its version changes, since write-only
+	// values cannot be compared with the prior state
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) && !password.IsNull() && !password.IsUnknown() {
+		params.Password = clerk.String(password.ValueString())
+		if !plan.SkipPasswordChecks.IsNull() && !plan.SkipPasswordChecks.IsUnknown() {
+			params.SkipPasswordChecks = clerk.Bool(plan.SkipPasswordChecks.ValueBool())
+		}
+	}
+
+	// Parse metadata. Metadata removed from the configuration is cleared
+	// when Terraform managed it, and left alone otherwise.
+	managed, diags := getManagedMetadata(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	params.PublicMetadata = clearMetadata(expandMetadata(plan.PublicMetadata, "public_metadata", &resp.Diagnostics), managed["public_metadata"])
+	params.PrivateMetadata = clearMetadata(expandMetadata(plan.PrivateMetadata, "private_metadata", &resp.Diagnostics), managed["private_metadata"])
+	params.UnsafeMetadata = clearMetadata(expandMetadata(plan.UnsafeMetadata, "unsafe_metadata", &resp.Diagnostics), managed["unsafe_metadata"])
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the user
+	_, err = r.client.UpdateUser(ctx, userID, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating user",
+			"Could not update user ID "+userID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Remove the email addresses and phone numbers no longer configured
+	for _, email := range usr.EmailAddresses {
+		if containsFold(planEmails, email.EmailAddress) || !containsFold(stateEmails, email.EmailAddress) {
+			continue
+		}
+		if err := r.client.DeleteEmailAddress(ctx, email.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error removing email address",
+				"Could not remove email address "+email.EmailAddress+" from user ID "+userID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	for _, phone := range usr.PhoneNumbers {
+		if containsFold(planPhones, phone.PhoneNumber) || !containsFold(statePhones, phone.PhoneNumber) {
+			continue
+		}
+		if err := r.client.DeletePhoneNumber(ctx, phone.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error removing phone number",
+				"Could not remove phone number "+phone.PhoneNumber+" from user ID "+userID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Fetch the user again to get the latest state from the API
+	usr, err = r.client.GetUser(ctx, userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading user after update",
+			"Could not read user ID "+userID+" after update: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	resp.Diagnostics.Append(plan.setUser(ctx, usr)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Record the metadata Terraform now manages
+	resp.Diagnostics.Append(setManagedMetadata(ctx, resp.Private, plan.managedMetadata())...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the user
+	err := r.client.DeleteUser(ctx, state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting user",
+			"Could not delete user ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing resource into Terraform state
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the ID field for import
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(markImported(ctx, resp.Private)...)
+}
+
+// setUser copies the attributes returned by the Clerk API into the model.
+// The password and skip_password_checks are never returned by the API and
+// are left untouched, as is metadata Terraform does not manage.
+func (m *userResourceModel) setUser(ctx context.Context, usr *clerk.User) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Username = types.StringPointerValue(usr.Username)
+	m.FirstName = types.StringPointerValue(usr.FirstName)
+	m.LastName = types.StringPointerValue(usr.LastName)
+	m.ExternalID = types.StringPointerValue(usr.ExternalID)
+
+	// Keep the configured order of identifiers, with the primary one first
+	var priorEmails, priorPhones []string
+	diags.Append(m.EmailAddresses.ElementsAs(ctx, &priorEmails, false)...)
+	diags.Append(m.PhoneNumbers.ElementsAs(ctx, &priorPhones, false)...)
+
+	var primaryEmail, primaryPhone string
+	emails := make([]string, 0, len(usr.EmailAddresses))
+	for _, email := range usr.EmailAddresses {
+		emails = append(emails, email.EmailAddress)
+		if usr.PrimaryEmailAddressID != nil && email.ID == *usr.PrimaryEmailAddressID {
+			primaryEmail = email.EmailAddress
+		}
+	}
+	phones := make([]string, 0, len(usr.PhoneNumbers))
+	for _, phone := range usr.PhoneNumbers {
+		phones = append(phones, phone.PhoneNumber)
+		if usr.PrimaryPhoneNumberID != nil && phone.ID == *usr.PrimaryPhoneNumberID {
+			primaryPhone = phone.PhoneNumber
+		}
+	}
+
+	var d diag.Diagnostics
+	m.EmailAddresses, d = identifierList(ctx, primaryEmail, emails, priorEmails, m.EmailAddresses.IsNull())
+	diags.Append(d...)
+	m.PhoneNumbers, d = identifierList(ctx, primaryPhone, phones, priorPhones, m.PhoneNumbers.IsNull())
+	diags.Append(d...)
+
+	if !m.PublicMetadata.IsNull() {
+		m.PublicMetadata = flattenMetadata(usr.PublicMetadata)
+	}
+	if !m.PrivateMetadata.IsNull() {
+		m.PrivateMetadata = flattenMetadata(usr.PrivateMetadata)
+	}
+	if !m.UnsafeMetadata.IsNull() {
+		m.UnsafeMetadata = flattenMetadata(usr.UnsafeMetadata)
+	}
+
+	return diags
+}
+
+// managedMetadata returns the metadata attributes set in the model
+func (m *userResourceModel) managedMetadata() map[string]bool {
+	return map[string]bool{
+		"public_metadata":  !m.PublicMetadata.IsNull(),
+		"private_metadata": !m.PrivateMetadata.IsNull(),
+		"unsafe_metadata":  !m.UnsafeMetadata.IsNull(),
+	}
+}
+
+// identifierList orders the identifiers of a user with the primary one
+// first, followed by the others in their prior order. Clerk stores email
+// addresses in lower case, so identifiers are matched case-insensitively and
+// keep their prior spelling to avoid a perpetual diff. An empty list is kept
+// null when the attribute was not configured.
+func identifierList(ctx context.Context, primary string, current, prior []string, priorNull bool) (types.List, diag.Diagnostics) {
+	if len(current) == 0 && priorNull {
+		return types.ListNull(types.StringType), nil
+	}
+
+	ordered := make([]string, 0, len(current))
+	if primary != "" {
+		if i := slices.IndexFunc(prior, func(value string) bool { return strings.EqualFold(value, primary) }); i >= 0 {
+			primary = prior[i]
+		}
+		ordered = append(ordered, primary)
+	}
+	for _, value := range prior {
+		if !strings.EqualFold(value, primary) && containsFold(current, value) && !containsFold(ordered, value) {
+			ordered = append(ordered, value)
+		}
+	}
+	for _, value := range current {
+		if !containsFold(ordered, value) {
+			ordered = append(ordered, value)
+		}
+	}
+
+	return types.ListValueFrom(ctx, types.StringType, ordered)
+}
+
+// containsFold reports whether values contains value, ignoring case
+func containsFold(values []string, value string) bool {
+	return slices.ContainsFunc(values, func(v string) bool {
+		return strings.EqualFold(v, value)
+	})
+}
+
+// optionalStringUpdate returns the value to send for an optional string
+// attribute, clearing it on Clerk when it was removed from configuration.
+func optionalStringUpdate(plan, state types.String) *string {
+	if !plan.IsNull() && !plan.IsUnknown() {
+		return clerk.String(plan.ValueString())
+	}
+	if !state.IsNull() {
+		return clerk.String("")
+	}
+	return nil
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccUserResource(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	email1 := fmt.Sprintf("bot+%s@example.com", rString)
+	email2 := fmt.Sprintf("bot-updated+%s@example.com", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserResourceConfig(email1, "Support"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_user.test", "email_addresses.#", "1"),
+					resource.TestCheckResourceAttr("clerk_user.test", "email_addresses.0", email1),
+					resource.TestCheckResourceAttr("clerk_user.test", "first_name", "Support"),
+					resource.TestCheckResourceAttrSet("clerk_user.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "clerk_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_password_checks"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccUserResourceConfig(email2, "Support Bot"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_user.test", "email_addresses.#", "1"),
+					resource.TestCheckResourceAttr("clerk_user.test", "email_addresses.0", email2),
+					resource.TestCheckResourceAttr("clerk_user.test", "first_name", "Support Bot"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserResource_withOrganization(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	email := fmt.Sprintf("owner+%s@example.com", rString)
+	slug := fmt.Sprintf("user-org-%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The managed user creates the organization
+			{
+				Config: testAccUserResourceConfigWithOrganization(email, slug),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("clerk_organization.test", "created_by", "clerk_user.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserResource_removeMetadata(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	email := fmt.Sprintf("metadata+%s@example.com", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with metadata
+			{
+				Config: testAccUserResourceConfig(email, "Metadata"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserPublicMetadata("clerk_user.test", `{"kind":"service-account"}`),
+				),
+			},
+			// Removing the attributes clears the metadata in Clerk once
+			{
+				Config: testAccUserResourceConfigMinimal(email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("clerk_user.test", "public_metadata"),
+					resource.TestCheckNoResourceAttr("clerk_user.test", "private_metadata"),
+					resource.TestCheckNoResourceAttr("clerk_user.test", "unsafe_metadata"),
+					testAccCheckUserPublicMetadata("clerk_user.test", `{}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserResource_password(t *testing.T) {
+	rString := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	// Clerk stores email addresses in lower case, so the mixed-case spelling
+	// must be kept in state without a diff
+	email := fmt.Sprintf("Password.Bot+%s@Example.com", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Write-only attributes need Terraform 1.11 or later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create with a password
+			{
+				Config: testAccUserResourceConfigPassword(email, "tf-test-"+rString+"-first", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_user.test", "email_addresses.0", email),
+					resource.TestCheckResourceAttr("clerk_user.test", "password_version", "1"),
+					resource.TestCheckNoResourceAttr("clerk_user.test", "password"),
+					testAccCheckUserPasswordEnabled("clerk_user.test"),
+				),
+			},
+			// Bumping the version sends the new password
+			{
+				Config: testAccUserResourceConfigPassword(email, "tf-test-"+rString+"-second", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("clerk_user.test", "email_addresses.0", email),
+					resource.TestCheckResourceAttr("clerk_user.test", "password_version", "2"),
+					testAccCheckUserPasswordEnabled("clerk_user.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestIdentifierList(t *testing.T) {
+	ctx := context.Background()
+	prior := []string{"Second@Example.com", "First@Example.com"}
+	current := []string{"first@example.com", "second@example.com", "third@example.com"}
+
+	list, diags := identifierList(ctx, "first@example.com", current, prior, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var got []string
+	list.ElementsAs(ctx, &got, false)
+	want := []string{"First@Example.com", "Second@Example.com", "third@example.com"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func testAccCheckUserPasswordEnabled(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		usr, err := testAccClerkClient().GetUser(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !usr.PasswordEnabled {
+			return fmt.Errorf("expected user %s to have a password", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckUserPublicMetadata(resourceName, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		usr, err := testAccClerkClient().GetUser(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !jsonSemanticEquals(string(usr.PublicMetadata), want) {
+			return fmt.Errorf("expected public metadata %s, got %s", want, usr.PublicMetadata)
+		}
+		return nil
+	}
+}
+
+func testAccUserResourceConfig(email, firstName string) string {
+	return fmt.Sprintf(`
+resource "clerk_user" "test" {
+  email_addresses      = [%[1]q]
+  first_name           = %[2]q
+  last_name            = "Account"
+  skip_password_checks = true
+
+  public_metadata = jsonencode({
+    kind = "service-account"
+  })
+}
+`, email, firstName)
+}
+
+func testAccUserResourceConfigMinimal(email string) string {
+	return fmt.Sprintf(`
+resource "clerk_user" "test" {
+  email_addresses = [%[1]q]
+}
+`, email)
+}
+
+func testAccUserResourceConfigPassword(email, password string, version int) string {
+	return fmt.Sprintf(`
+resource "clerk_user" "test" {
+  email_addresses      = [%[1]q]
+  password             = %[2]q
+  password_version     = %[3]d
+  skip_password_checks = true
+}
+`, email, password, version)
+}
+
+func testAccUserResourceConfigWithOrganization(email, slug string) string {
+	return fmt.Sprintf(`
+resource "clerk_user" "test" {
+  email_addresses = [%[1]q]
+}
+
+resource "clerk_organization" "test" {
+  name       = "User Org"
+  slug       = %[2]q
+  created_by = clerk_user.test.id
+}
+`, email, slug)
+}
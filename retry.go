@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Default retry policy used when the provider configuration does not set one
+const (
+	defaultMaxRetries = 3
+	defaultMinBackoff = 1 * time.Second
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// attemptTimeout bounds a single attempt, from sending the request until
+// the response body is closed, so a stalled connection cannot hang an apply
+const attemptTimeout = 60 * time.Second
+
+// retryPolicy describes how failed Clerk API calls are retried
+type retryPolicy struct {
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// retryTransport is an http.RoundTripper that retries Clerk API calls
+// rejected with 429 Too Many Requests or failed with a 5xx status.
+//
+// Rate-limited calls are always retried, since Clerk rejects them before
+// doing any work. Server errors and network failures are only retried for
+// idempotent methods: a POST or PATCH that failed halfway may already have
+// created or changed something, and sending it again could duplicate it.
+type retryTransport struct {
+	next    http.RoundTripper
+	policy  retryPolicy
+	timeout time.Duration
+
+	// sleep waits between attempts; overridden in tests
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// newRetryHTTPClient returns an HTTP client that applies the retry policy
+// to every request made through it
+func newRetryHTTPClient(policy retryPolicy) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = 30 * time.Second
+
+	return &http.Client{
+		Transport: &retryTransport{
+			next:    transport,
+			policy:  policy,
+			timeout: attemptTimeout,
+			sleep:   sleepContext,
+		},
+	}
+}
+
+// RoundTrip sends the request, retrying it according to the retry policy.
+// Every attempt sends its own copy of the request, so the caller's request
+// is never modified.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		attemptCtx, cancel := context.WithTimeout(ctx, t.timeout)
+		attemptReq := req.Clone(attemptCtx)
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.policy.MaxRetries || !t.shouldRetry(req, resp, err) {
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			// The deadline also covers reading the body
+			resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+
+		wait := t.backoff(attempt, resp)
+
+		fields := map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		cancel()
+		tflog.Warn(ctx, "Retrying Clerk API request", fields)
+
+		if err := t.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// cancelOnClose releases the deadline of the last attempt once the caller
+// closes the response body
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the context of the attempt
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// shouldRetry reports whether a failed attempt can safely be sent again
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		return isIdempotent(req.Method)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return resp.StatusCode >= 500 && isIdempotent(req.Method)
+}
+
+// backoff returns how long to wait before the next attempt. A Retry-After
+// header sent by Clerk takes precedence over the exponential backoff, but is
+// still capped at the maximum backoff so a large value cannot stall an apply.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, t.policy.MaxBackoff)
+		}
+	}
+
+	wait := t.policy.MinBackoff << attempt
+	// Add up to 20% jitter so parallel resources do not retry in lockstep
+	if jitter := int64(wait) / 5; jitter > 0 {
+		wait += time.Duration(rand.Int64N(jitter))
+	}
+	if wait <= 0 || wait > t.policy.MaxBackoff {
+		wait = t.policy.MaxBackoff
+	}
+	return wait
+}
+
+// isIdempotent reports whether sending a request with the method twice has
+// the same effect as sending it once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as
+// an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds >= 0 {
+		// Saturate instead of overflowing on absurdly large values
+		if seconds > int64(math.MaxInt64/time.Second) {
+			return time.Duration(math.MaxInt64), true
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// sleepContext waits for the given duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	testCases := map[string]struct {
+		method       string
+		statuses     []int
+		wantStatus   int
+		wantAttempts int32
+	}{
+		"rate limited GET is retried": {
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 3,
+		},
+		"rate limited POST is retried": {
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		"server error on GET is retried": {
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusBadGateway, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantAttempts: 2,
+		},
+		"server error on POST is not retried": {
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusInternalServerError, http.StatusOK},
+			wantStatus:   http.StatusInternalServerError,
+			wantAttempts: 1,
+		},
+		"client error is not retried": {
+			method:       http.MethodDelete,
+			statuses:     []int{http.StatusNotFound, http.StatusOK},
+			wantStatus:   http.StatusNotFound,
+			wantAttempts: 1,
+		},
+		"gives up after max retries": {
+			method:       http.MethodGet,
+			statuses:     []int{503, 503, 503, 503, 503},
+			wantStatus:   http.StatusServiceUnavailable,
+			wantAttempts: 4,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+				if body, _ := io.ReadAll(r.Body); r.Method == http.MethodPost && string(body) != `{"name":"acme"}` {
+					t.Errorf("attempt %d: unexpected body %q", n, body)
+				}
+				w.WriteHeader(tc.statuses[n-1])
+			}))
+			defer server.Close()
+
+			client := newRetryHTTPClient(retryPolicy{MaxRetries: 3, MinBackoff: time.Second, MaxBackoff: time.Minute})
+			client.Transport.(*retryTransport).sleep = func(context.Context, time.Duration) error { return nil }
+
+			var body io.Reader
+			if tc.method == http.MethodPost {
+				body = strings.NewReader(`{"name":"acme"}`)
+			}
+			req, err := http.NewRequest(tc.method, server.URL, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.wantStatus {
+				t.Errorf("expected status %d, got %d", tc.wantStatus, resp.StatusCode)
+			}
+			if got := attempts.Load(); got != tc.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tc.wantAttempts, got)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_keepsRequest(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		if body, _ := io.ReadAll(r.Body); string(body) != `{"name":"acme"}` {
+			t.Errorf("unexpected body %q", body)
+		}
+	}))
+	defer server.Close()
+
+	transport := newRetryHTTPClient(retryPolicy{MaxRetries: 3, MinBackoff: time.Second, MaxBackoff: time.Minute}).Transport.(*retryTransport)
+	transport.sleep = func(context.Context, time.Duration) error { return nil }
+
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"name":"acme"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := req.Body
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || attempts.Load() != 2 {
+		t.Errorf("expected success on the second attempt, got status %d after %d attempts", resp.StatusCode, attempts.Load())
+	}
+	if req.Body != body {
+		t.Error("expected the caller's request body to be left untouched")
+	}
+}
+
+func TestRetryTransport_attemptTimeout(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first attempt stalls until the client gives up on it
+		if attempts.Add(1) == 1 {
+			<-r.Context().Done()
+		}
+	}))
+	defer server.Close()
+
+	client := newRetryHTTPClient(retryPolicy{MaxRetries: 3, MinBackoff: time.Second, MaxBackoff: time.Minute})
+	client.Transport.(*retryTransport).timeout = 50 * time.Millisecond
+	client.Transport.(*retryTransport).sleep = func(context.Context, time.Duration) error { return nil }
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("expected the stalled attempt to be retried, got %d attempts", got)
+	}
+}
+
+func TestRetryTransport_backoff(t *testing.T) {
+	transport := &retryTransport{policy: retryPolicy{MaxRetries: 5, MinBackoff: time.Second, MaxBackoff: 5 * time.Second}}
+
+	// Retry-After takes precedence over the exponential backoff
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if got := transport.backoff(0, resp); got != 3*time.Second {
+		t.Errorf("expected Retry-After of 3s to be honored, got %s", got)
+	}
+
+	// but is capped at the maximum backoff
+	for _, value := range []string{"7", "86400", "99999999999999999"} {
+		resp := &http.Response{Header: http.Header{"Retry-After": []string{value}}}
+		if got := transport.backoff(0, resp); got != 5*time.Second {
+			t.Errorf("expected Retry-After of %s to be capped at 5s, got %s", value, got)
+		}
+	}
+
+	// Exponential backoff with jitter, capped at the maximum
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		got := transport.backoff(attempt, &http.Response{Header: http.Header{}})
+		if got < want || got > want+want/5 || got > 5*time.Second {
+			t.Errorf("attempt %d: expected backoff of about %s, got %s", attempt, want, got)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if got, ok := parseRetryAfter("3"); !ok || got != 3*time.Second {
+		t.Errorf("expected 3s, got %s (ok=%t)", got, ok)
+	}
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Error("expected an invalid Retry-After header to be ignored")
+	}
+	date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	if got, ok := parseRetryAfter(date); !ok || got <= 0 || got > 10*time.Second {
+		t.Errorf("expected a wait of up to 10s, got %s (ok=%t)", got, ok)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// slugPattern matches the slugs Clerk accepts for organizations: lowercase
+// letters, digits and dashes
+var slugPattern = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// validSlug returns a validator that rejects slugs Clerk would refuse, so
+// the mistake shows up in the plan rather than halfway through an apply
+func validSlug() validator.String {
+	return slugValidator{}
+}
+
+// slugValidator implements the slug validator
+type slugValidator struct{}
+
+// Description returns a human-readable description of the validator
+func (v slugValidator) Description(_ context.Context) string {
+	return "value must only contain lowercase letters, digits and dashes"
+}
+
+// MarkdownDescription returns a markdown description of the validator
+func (v slugValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString implements the validation logic
+func (v slugValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !slugPattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Slug",
+			"The slug can only contain lowercase letters, digits and dashes, e.g. acme-inc. Got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidSlug(t *testing.T) {
+	for _, tc := range []struct {
+		value     types.String
+		wantError bool
+	}{
+		{types.StringValue("acme"), false},
+		{types.StringValue("acme-inc-2"), false},
+		{types.StringNull(), false},
+		{types.StringUnknown(), false},
+		{types.StringValue(""), true},
+		{types.StringValue("Acme"), true},
+		{types.StringValue("acme inc"), true},
+		{types.StringValue("acme_inc"), true},
+	} {
+		req := validator.StringRequest{Path: path.Root("slug"), ConfigValue: tc.value}
+		resp := &validator.StringResponse{}
+		validSlug().ValidateString(context.Background(), req, resp)
+
+		if got := resp.Diagnostics.HasError(); got != tc.wantError {
+			t.Errorf("%s: expected error %t, got %t", tc.value, tc.wantError, got)
+		}
+	}
+}